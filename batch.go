@@ -0,0 +1,237 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Batch is a group of tasks processed together
+//
+// Documentation: https://www.meilisearch.com/docs/reference/api/batches
+type Batch struct {
+	UID int64 `json:"uid"`
+	// Progress is the progress of the batch while it is processed, nil once it is finished
+	Progress *BatchProgress `json:"progress"`
+	// Details are the details of the tasks of the batch merged together
+	Details       map[string]interface{} `json:"details,omitempty"`
+	Stats         BatchStats             `json:"stats"`
+	Duration      time.Duration          `json:"duration,omitempty"`
+	StartedAt     time.Time              `json:"startedAt,omitempty"`
+	FinishedAt    time.Time              `json:"finishedAt,omitempty"`
+	BatchStrategy string                 `json:"batchStrategy,omitempty"`
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface, the duration is parsed from ISO-8601
+func (b *Batch) UnmarshalJSON(data []byte) error {
+	type batch Batch
+	raw := struct {
+		*batch
+		Duration string `json:"duration"`
+	}{batch: (*batch)(b)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	duration, err := parseISO8601Duration(raw.Duration)
+	if err != nil {
+		return err
+	}
+	b.Duration = duration
+	return nil
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (b Batch) MarshalJSON() ([]byte, error) {
+	type batch Batch
+	return json.Marshal(struct {
+		batch
+		Duration string `json:"duration,omitempty"`
+	}{batch: batch(b), Duration: formatISO8601Duration(b.Duration)})
+}
+
+// BatchProgress is the progress of a batch being processed
+type BatchProgress struct {
+	// Steps are the nested steps being processed, from the outermost to the innermost
+	Steps      []BatchProgressStep `json:"steps"`
+	Percentage float64             `json:"percentage"`
+}
+
+// CurrentStep returns the names of the steps being processed, eg. `processing tasks > indexing`
+func (p *BatchProgress) CurrentStep() string {
+	names := make([]string, len(p.Steps))
+	for i, step := range p.Steps {
+		names[i] = step.CurrentStep
+	}
+	return strings.Join(names, " > ")
+}
+
+// BatchProgressStep is a step of a batch, Finished out of Total sub-steps are done
+type BatchProgressStep struct {
+	CurrentStep string `json:"currentStep"`
+	Finished    int64  `json:"finished"`
+	Total       int64  `json:"total"`
+}
+
+// BatchStats are the statistics of the tasks of a batch
+type BatchStats struct {
+	TotalNbTasks int64                `json:"totalNbTasks"`
+	Status       map[TaskStatus]int64 `json:"status"`
+	Types        map[TaskType]int64   `json:"types"`
+	IndexUIDs    map[string]int64     `json:"indexUids"`
+	// ProgressTrace is the time spent in every step once the batch is finished
+	ProgressTrace map[string]string `json:"progressTrace,omitempty"`
+}
+
+// BatchesQuery is a list of filter available to send as query parameters
+type BatchesQuery struct {
+	UIDS             []int64
+	BatchUIDS        []int64
+	Limit            int64
+	From             int64
+	IndexUIDS        []string
+	Statuses         []TaskStatus
+	Types            []TaskType
+	CanceledBy       []int64
+	BeforeEnqueuedAt time.Time
+	AfterEnqueuedAt  time.Time
+	BeforeStartedAt  time.Time
+	AfterStartedAt   time.Time
+	BeforeFinishedAt time.Time
+	AfterFinishedAt  time.Time
+	Reverse          bool
+}
+
+// BatchesResults return of multiple batches is wrap in a BatchesResults
+type BatchesResults struct {
+	Results []Batch `json:"results"`
+	Limit   int64   `json:"limit"`
+	From    int64   `json:"from"`
+	// Next is the uid to pass as From to get the next page, nil after the last page
+	Next  *int64 `json:"next"`
+	Total int64  `json:"total"`
+}
+
+// TaskProgress is given to the callback of WaitForTaskWithProgress while the task is processed
+type TaskProgress struct {
+	Task *Task
+	// Batch is the batch processing the task, nil when the task is finished
+	Batch *Batch
+	// Step is the current step of the batch, empty when the task is finished
+	Step       string
+	Percentage float64
+}
+
+func encodeBatchesQuery(param *BatchesQuery, req *internalRequest) {
+	encodeTasksQuery(&TasksQuery{
+		UIDS:             param.UIDS,
+		Limit:            param.Limit,
+		From:             param.From,
+		IndexUIDS:        param.IndexUIDS,
+		Statuses:         param.Statuses,
+		Types:            param.Types,
+		CanceledBy:       param.CanceledBy,
+		BeforeEnqueuedAt: param.BeforeEnqueuedAt,
+		AfterEnqueuedAt:  param.AfterEnqueuedAt,
+		BeforeStartedAt:  param.BeforeStartedAt,
+		AfterStartedAt:   param.AfterStartedAt,
+		BeforeFinishedAt: param.BeforeFinishedAt,
+		AfterFinishedAt:  param.AfterFinishedAt,
+		Reverse:          param.Reverse,
+	}, req)
+	if len(param.BatchUIDS) != 0 {
+		uids := make([]string, len(param.BatchUIDS))
+		for i, uid := range param.BatchUIDS {
+			uids[i] = strconv.FormatInt(uid, 10)
+		}
+		req.withQueryParams["batchUids"] = strings.Join(uids, ",")
+	}
+}
+
+func getBatch(ctx context.Context, cli *client, batchUID int64) (*Batch, error) {
+	resp := new(Batch)
+	req := &internalRequest{
+		endpoint:            "/batches/" + strconv.FormatInt(batchUID, 10),
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetBatch",
+	}
+	if err := cli.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func getBatches(ctx context.Context, cli *client, param *BatchesQuery) (*BatchesResults, error) {
+	resp := new(BatchesResults)
+	req := &internalRequest{
+		endpoint:            "/batches",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		withQueryParams:     map[string]string{},
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetBatches",
+	}
+	if param != nil {
+		encodeBatchesQuery(param, req)
+	}
+	if err := cli.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// waitForTaskWithProgress waits for the task like waitForTask and calls fn with the progress of its
+// batch after every poll while it is processed, then with a percentage of 100 if it succeeded.
+// A poll whose batch cannot be fetched does not call fn. A nil fn only waits for the task.
+func waitForTaskWithProgress(ctx context.Context, cli *client, taskUID int64, interval time.Duration, fn func(TaskProgress)) (*Task, error) {
+	if fn == nil {
+		fn = func(TaskProgress) {}
+	}
+	task, err := pollTask(ctx, cli, taskUID, interval, func(task *Task) error {
+		if task.Status != TaskStatusProcessing || task.BatchUID == nil {
+			return nil
+		}
+		batch, err := getBatch(ctx, cli, *task.BatchUID)
+		if err != nil {
+			// the progress is skipped, only the task decides the outcome of the wait
+			return nil
+		}
+		if batch.Progress != nil {
+			fn(TaskProgress{Task: task, Batch: batch, Step: batch.Progress.CurrentStep(), Percentage: batch.Progress.Percentage})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if task.Status == TaskStatusSucceeded {
+		fn(TaskProgress{Task: task, Percentage: 100})
+	}
+	return task, nil
+}
+
+func (m *meilisearch) GetBatch(batchUID int64) (*Batch, error) {
+	return m.GetBatchWithContext(context.Background(), batchUID)
+}
+
+func (m *meilisearch) GetBatchWithContext(ctx context.Context, batchUID int64) (*Batch, error) {
+	return getBatch(ctx, m.client, batchUID)
+}
+
+func (m *meilisearch) GetBatches(param *BatchesQuery) (*BatchesResults, error) {
+	return m.GetBatchesWithContext(context.Background(), param)
+}
+
+func (m *meilisearch) GetBatchesWithContext(ctx context.Context, param *BatchesQuery) (*BatchesResults, error) {
+	return getBatches(ctx, m.client, param)
+}
+
+func (m *meilisearch) WaitForTaskWithProgress(ctx context.Context, taskUID int64, interval time.Duration, fn func(TaskProgress)) (*Task, error) {
+	return waitForTaskWithProgress(ctx, m.client, taskUID, interval, fn)
+}
@@ -0,0 +1,139 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch_UnmarshalJSON(t *testing.T) {
+	var batch Batch
+	require.NoError(t, json.Unmarshal([]byte(`{"uid":3,"progress":{"steps":[{"currentStep":"processing tasks","finished":0,"total":2},`+
+		`{"currentStep":"indexing","finished":1,"total":3}],"percentage":16.66},"details":{"receivedDocuments":10},`+
+		`"stats":{"totalNbTasks":2,"status":{"processing":2},"types":{"documentAdditionOrUpdate":2},"indexUids":{"movies":2}},`+
+		`"duration":"PT1.5S","startedAt":"2024-12-10T10:00:00Z","finishedAt":null,"batchStrategy":"batched all enqueued tasks"}`), &batch))
+
+	require.Equal(t, int64(3), batch.UID)
+	require.Equal(t, "processing tasks > indexing", batch.Progress.CurrentStep())
+	require.Equal(t, 16.66, batch.Progress.Percentage)
+	require.Equal(t, int64(2), batch.Stats.Status[TaskStatusProcessing])
+	require.Equal(t, int64(2), batch.Stats.Types[TaskTypeDocumentAdditionOrUpdate])
+	require.Equal(t, 1500*time.Millisecond, batch.Duration)
+	require.Equal(t, "batched all enqueued tasks", batch.BatchStrategy)
+
+	data, err := json.Marshal(batch)
+	require.NoError(t, err)
+	var decoded Batch
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, batch, decoded)
+
+	require.Error(t, json.Unmarshal([]byte(`{"uid":1,"duration":"1.5S"}`), &batch))
+}
+
+func TestGetBatches(t *testing.T) {
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		switch r.URL.Path {
+		case "/batches/1":
+			_, _ = w.Write([]byte(`{"uid":1,"progress":null,"stats":{"totalNbTasks":1}}`))
+		case "/batches":
+			if r.URL.Query().Has("indexUids") {
+				_, _ = w.Write([]byte(`{"results":[],"limit":20,"from":null,"next":null,"total":0}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"results":[{"uid":1,"progress":null}],"limit":1,"from":1,"next":0,"total":2}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL)
+	batch, err := client.GetBatch(1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), batch.Stats.TotalNbTasks)
+
+	batches, err := client.GetBatches(&BatchesQuery{Limit: 1, BatchUIDS: []int64{1, 2}, Statuses: []TaskStatus{TaskStatusSucceeded}, Reverse: true})
+	require.NoError(t, err)
+	require.Len(t, batches.Results, 1)
+	require.Equal(t, "batchUids=1%2C2&limit=1&reverse=true&statuses=succeeded", query)
+	// a next batch uid 0 is not mistaken for the last page
+	require.NotNil(t, batches.Next)
+	require.Equal(t, int64(0), *batches.Next)
+
+	batches, err = client.Index("movies").GetBatches(&BatchesQuery{IndexUIDS: []string{"books"}})
+	require.NoError(t, err)
+	require.Equal(t, "indexUids=movies", query)
+	require.Nil(t, batches.Next)
+}
+
+func TestWaitForTaskWithProgress(t *testing.T) {
+	var polls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tasks/7":
+			switch n := atomic.AddInt32(&polls, 1); {
+			case n == 1:
+				_, _ = w.Write([]byte(`{"uid":7,"status":"enqueued","type":"documentAdditionOrUpdate"}`))
+			case n <= 3:
+				_, _ = w.Write([]byte(`{"uid":7,"status":"processing","type":"documentAdditionOrUpdate","batchUid":2}`))
+			default:
+				_, _ = w.Write([]byte(`{"uid":7,"status":"succeeded","type":"documentAdditionOrUpdate","batchUid":2}`))
+			}
+		case "/batches/2":
+			done := atomic.LoadInt32(&polls) - 1
+			_, _ = fmt.Fprintf(w, `{"uid":2,"progress":{"steps":[{"currentStep":"indexing","finished":%d,"total":4}],"percentage":%d}}`,
+				done, done*25)
+		}
+	}))
+	defer ts.Close()
+
+	var progress []TaskProgress
+	task, err := New(ts.URL).WaitForTaskWithProgress(context.Background(), 7, time.Millisecond, func(p TaskProgress) {
+		progress = append(progress, p)
+	})
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusSucceeded, task.Status)
+	require.Equal(t, int64(2), *task.BatchUID)
+
+	require.Len(t, progress, 3)
+	require.Equal(t, "indexing", progress[0].Step)
+	require.Equal(t, float64(25), progress[0].Percentage)
+	require.Equal(t, int64(2), progress[0].Batch.UID)
+	require.Equal(t, float64(50), progress[1].Percentage)
+	require.Equal(t, TaskProgress{Task: task, Percentage: 100}, progress[2])
+
+	// a batch which cannot be fetched only skips the progress of the poll
+	atomic.StoreInt32(&polls, 0)
+	var batchRequests int32
+	handler := ts.Config.Handler
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/batches/2" && atomic.AddInt32(&batchRequests, 1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Batch not found.","code":"batch_not_found","type":"invalid_request","link":""}`))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+	progress = nil
+	task, err = New(ts.URL, DisableRetries()).WaitForTaskWithProgress(context.Background(), 7, time.Millisecond, func(p TaskProgress) {
+		progress = append(progress, p)
+	})
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusSucceeded, task.Status)
+	require.Len(t, progress, 2)
+	require.Equal(t, float64(50), progress[0].Percentage)
+	ts.Config.Handler = handler
+
+	// fn is optional
+	atomic.StoreInt32(&polls, 0)
+	task, err = New(ts.URL).WaitForTaskWithProgress(context.Background(), 7, time.Millisecond, nil)
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusSucceeded, task.Status)
+}
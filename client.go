@@ -3,35 +3,61 @@ package meilisearch
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type client struct {
-	client          *http.Client
-	host            string
-	apiKey          string
-	bufferPool      *sync.Pool
-	encoder         encoder
-	contentEncoding ContentEncoding
-	retryOnStatus   map[int]bool
-	disableRetry    bool
-	maxRetries      uint8
-	retryBackoff    func(attempt uint8) time.Duration
+	client           *http.Client
+	host             string
+	apiKey           string
+	bufferPool       *sync.Pool
+	compressionLevel EncodingCompressionLevel
+	// requestEncoding compresses the request bodies, it falls back to an encoding accepted by
+	// meilisearch when it does not accept the configured one
+	requestEncoding atomic.Pointer[requestEncoding]
+	// compressionThreshold is the size below which the request bodies are sent uncompressed
+	compressionThreshold int
+	// acceptEncoding is the Accept-Encoding header of the requests, the responses are decoded
+	// according to their Content-Encoding
+	acceptEncoding string
+	retryOnStatus  map[int]bool
+	disableRetry   bool
+	maxRetries     uint8
+	retryBackoff   func(attempt uint8) time.Duration
+
+	autoEnableFeatures bool
+	indexTemplates     []IndexTemplate
+	bodyCapture        *BodyCapture
+	logger             *slog.Logger
+	logBodies          bool
+	jsonCodec          JSONCodec
+	connectionStats    func(ctx context.Context, stats ConnectionStats)
 }
 
 type clientConfig struct {
 	contentEncoding          ContentEncoding
 	encodingCompressionLevel EncodingCompressionLevel
+	acceptEncodings          []ContentEncoding
+	compressionThreshold     int
 	retryOnStatus            map[int]bool
 	disableRetry             bool
 	maxRetries               uint8
+	autoEnableFeatures       bool
+	indexTemplates           []IndexTemplate
+	bodyCapture              *BodyCapture
+	logger                   *slog.Logger
+	logBodies                bool
+	jsonCodec                JSONCodec
+	connectionStats          func(ctx context.Context, stats ConnectionStats)
 }
 
 type internalRequest struct {
@@ -45,6 +71,16 @@ type internalRequest struct {
 	acceptedStatusCodes []int
 
 	functionName string
+
+	// streamRequest marshals the documents of withRequest one by one while they are sent instead of
+	// buffering the whole body
+	streamRequest bool
+
+	// streamResponse decodes the response while it is read, it accepts the content encoding like withResponse
+	streamResponse bool
+
+	// experimentalFeature is the experimental feature the request depends on, if any
+	experimentalFeature ExperimentalFeature
 }
 
 func newClient(cli *http.Client, host, apiKey string, cfg clientConfig) *client {
@@ -60,6 +96,14 @@ func newClient(cli *http.Client, host, apiKey string, cfg clientConfig) *client
 		disableRetry:  cfg.disableRetry,
 		maxRetries:    cfg.maxRetries,
 		retryOnStatus: cfg.retryOnStatus,
+
+		autoEnableFeatures: cfg.autoEnableFeatures,
+		indexTemplates:     cfg.indexTemplates,
+		bodyCapture:        cfg.bodyCapture,
+		logger:             cfg.logger,
+		logBodies:          cfg.logBodies,
+		jsonCodec:          codecOrDefault(cfg.jsonCodec),
+		connectionStats:    cfg.connectionStats,
 	}
 
 	if c.retryOnStatus == nil {
@@ -76,27 +120,107 @@ func newClient(cli *http.Client, host, apiKey string, cfg clientConfig) *client
 		}
 	}
 
+	c.compressionLevel = cfg.encodingCompressionLevel
+	c.compressionThreshold = cfg.compressionThreshold
 	if !cfg.contentEncoding.IsZero() {
-		c.contentEncoding = cfg.contentEncoding
-		c.encoder = newEncoding(cfg.contentEncoding, cfg.encodingCompressionLevel)
+		c.requestEncoding.Store(&requestEncoding{
+			encoding: cfg.contentEncoding,
+			encoder:  newEncoding(cfg.contentEncoding, cfg.encodingCompressionLevel, c.jsonCodec),
+		})
+	} else {
+		c.requestEncoding.Store(&requestEncoding{})
+	}
+
+	accepted := make([]string, 0, len(cfg.acceptEncodings))
+	for _, encoding := range cfg.acceptEncodings {
+		if !encoding.IsZero() {
+			accepted = append(accepted, encoding.String())
+		}
 	}
+	c.acceptEncoding = strings.Join(accepted, ", ")
 
 	return c
 }
 
 func (c *client) executeRequest(ctx context.Context, req *internalRequest) error {
-	internalError := &Error{
+	return c.withExperimentalFeature(ctx, req, func() error {
+		return c.execute(ctx, req)
+	})
+}
+
+// executeStreamRequest sends the request and returns the response once its status code is checked,
+// for the endpoints which do not answer with a single JSON document. The caller must close the body.
+func (c *client) executeStreamRequest(ctx context.Context, req *internalRequest) (resp *http.Response, err error) {
+	err = c.withExperimentalFeature(ctx, req, func() error {
+		resp, err = c.stream(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+// withExperimentalFeature runs send and handles the error returned when the experimental feature
+// the request depends on is not enabled
+func (c *client) withExperimentalFeature(ctx context.Context, req *internalRequest, send func() error) error {
+	err := send()
+	if req.experimentalFeature == "" || !featureNotEnabled(err) {
+		return err
+	}
+	if !c.autoEnableFeatures {
+		return &ExperimentalFeatureError{Feature: req.experimentalFeature, Function: req.functionName, Err: err}
+	}
+
+	if _, err := (&ExperimentalFeatures{client: c}).Set(req.experimentalFeature, true).UpdateWithContext(ctx); err != nil {
+		return err
+	}
+	return send()
+}
+
+func (c *client) newInternalError(req *internalRequest) *Error {
+	return &Error{
 		Endpoint:         req.endpoint,
 		Method:           req.method,
 		Function:         req.functionName,
 		RequestToString:  "empty request",
 		ResponseToString: "empty response",
-		MeilisearchApiError: meilisearchApiError{
+		MeilisearchApiError: APIError{
 			Message: "empty meilisearch message",
 		},
 		StatusCodeExpected: req.acceptedStatusCodes,
-		encoder:            c.encoder,
+		bodyCapture:        c.bodyCapture,
+		jsonCodec:          c.jsonCodec,
+	}
+}
+
+func (c *client) stream(ctx context.Context, req *internalRequest) (*http.Response, error) {
+	internalError := c.newInternalError(req)
+
+	resp, err := c.sendRequest(ctx, req, internalError)
+	if err != nil {
+		return nil, err
+	}
+	internalError.StatusCode = resp.StatusCode
+
+	if req.acceptedStatusCodes == nil {
+		return resp, nil
+	}
+	for _, acceptedCode := range req.acceptedStatusCodes {
+		if resp.StatusCode == acceptedCode {
+			return resp, nil
+		}
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	b, err := readBody(resp, internalError)
+	if err != nil {
+		return nil, err
 	}
+	return nil, c.handleStatusCode(req, resp.StatusCode, b, internalError)
+}
+
+func (c *client) execute(ctx context.Context, req *internalRequest) error {
+	internalError := c.newInternalError(req)
 
 	resp, err := c.sendRequest(ctx, req, internalError)
 	if err != nil {
@@ -109,10 +233,11 @@ func (c *client) executeRequest(ctx context.Context, req *internalRequest) error
 
 	internalError.StatusCode = resp.StatusCode
 
-	b, err := io.ReadAll(resp.Body)
+	b, err := readBody(resp, internalError)
 	if err != nil {
 		return err
 	}
+	c.logBody(ctx, "meilisearch response body", internalError, b)
 
 	err = c.handleStatusCode(req, resp.StatusCode, b, internalError)
 	if err != nil {
@@ -126,6 +251,25 @@ func (c *client) executeRequest(ctx context.Context, req *internalRequest) error
 	return nil
 }
 
+// readBody reads the body of the response, it is decompressed according to its Content-Encoding
+func readBody(resp *http.Response, internalError *Error) ([]byte, error) {
+	body, err := decompressBody(resp)
+	if err != nil {
+		return nil, internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	b, err := io.ReadAll(body)
+	if err != nil {
+		if body != resp.Body {
+			return nil, internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
+		}
+		return nil, err
+	}
+	return b, nil
+}
+
 func (c *client) sendRequest(
 	ctx context.Context,
 	req *internalRequest,
@@ -146,8 +290,12 @@ func (c *client) sendRequest(
 		apiURL.RawQuery = query.Encode()
 	}
 
-	// Create request body
-	var body io.Reader = nil
+	// Create request body, it is either held in memory or streamed
+	var (
+		raw        *bytes.Buffer
+		stream     bodySource
+		reopenable bool
+	)
 	if req.withRequest != nil {
 		if req.method == http.MethodGet || req.method == http.MethodHead {
 			return nil, ErrInvalidRequestMethod
@@ -158,55 +306,115 @@ func (c *client) sendRequest(
 
 		rawRequest := req.withRequest
 
-		buf := c.bufferPool.Get().(*bytes.Buffer)
-		buf.Reset()
-
-		if b, ok := rawRequest.([]byte); ok {
-			buf.Write(b)
-			body = buf
-		} else if reader, ok := rawRequest.(io.Reader); ok {
+		switch reader := rawRequest.(type) {
+		case *bytes.Buffer:
+			raw = reader
+		case io.Reader:
 			// If the request body is an io.Reader then stream it directly
-			body = reader
-		} else {
-			// Otherwise convert it to JSON
-			var (
-				data []byte
-				err  error
-			)
-			if marshaler, ok := rawRequest.(json.Marshaler); ok {
-				data, err = marshaler.MarshalJSON()
-				if err != nil {
-					return nil, internalError.WithErrCode(ErrCodeMarshalRequest,
-						fmt.Errorf("failed to marshal with MarshalJSON: %w", err))
-				}
-				if data == nil {
-					return nil, internalError.WithErrCode(ErrCodeMarshalRequest,
-						errors.New("MarshalJSON returned nil data"))
-				}
-			} else {
-				data, err = json.Marshal(rawRequest)
-				if err != nil {
-					return nil, internalError.WithErrCode(ErrCodeMarshalRequest,
-						fmt.Errorf("failed to marshal with json.Marshal: %w", err))
-				}
+			stream, reopenable = readerSource(reader)
+		default:
+			if _, ok := rawRequest.([]byte); !ok && req.streamRequest {
+				// The documents are marshaled one by one while they are sent
+				stream, reopenable = jsonSource(rawRequest, c.jsonCodec), true
+				break
+			}
+			if raw, err = c.marshalRequest(ctx, rawRequest, internalError); err != nil {
+				return nil, err
 			}
-			buf.Write(data)
-			body = buf
+			defer c.bufferPool.Put(raw)
 		}
+	}
+
+	enc := c.requestEncoding.Load()
+	request, release, err := c.newRequest(ctx, req, apiURL.String(), enc, raw, stream, reopenable, internalError)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	resp, err := c.do(request, internalError)
+	if err == nil && resp.StatusCode == http.StatusUnsupportedMediaType && request.Header.Get("Content-Encoding") != "" &&
+		(raw != nil || reopenable) {
+		// meilisearch does not accept the content encoding, send the request again with an encoding it accepts
+		_ = resp.Body.Close()
+		if body, ok := request.Body.(*pipeReader); ok {
+			// the source is opened again, the goroutine compressing it must not read it anymore
+			body.join()
+		}
+		enc = c.fallbackEncoding(ctx, enc, resp, internalError)
+		request, release, err = c.newRequest(ctx, req, apiURL.String(), enc, raw, stream, reopenable, internalError)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		resp, err = c.do(request, internalError)
+	}
+	if err != nil {
+		var marshalErr *marshalError
+		if errors.As(err, &marshalErr) {
+			return nil, internalError.WithErrCode(ErrCodeMarshalRequest, marshalErr)
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// newRequest creates the HTTP request with its body compressed with enc, release gives back the
+// buffer of the compressed body once the request is sent
+func (c *client) newRequest(
+	ctx context.Context,
+	req *internalRequest,
+	apiURL string,
+	enc *requestEncoding,
+	raw *bytes.Buffer,
+	stream bodySource,
+	reopenable bool,
+	internalError *Error,
+) (request *http.Request, release func(), err error) {
+	release = func() {}
+
+	if raw != nil && raw.Len() < c.compressionThreshold {
+		// small bodies are sent uncompressed
+		enc = &requestEncoding{}
+	}
 
-		if !c.contentEncoding.IsZero() {
-			body, err = c.encoder.Encode(body)
-			if err != nil {
-				return nil, internalError.WithErrCode(ErrCodeMarshalRequest,
-					fmt.Errorf("failed to marshal with json.Marshal: %w", err))
+	var body io.Reader
+	if raw != nil {
+		body = bytes.NewReader(raw.Bytes())
+		if !enc.encoding.IsZero() {
+			compressed := c.bufferPool.Get().(*bytes.Buffer)
+			compressed.Reset()
+			if err := enc.encoder.EncodeTo(compressed, body); err != nil {
+				c.bufferPool.Put(compressed)
+				return nil, nil, internalError.WithErrCode(ErrCodeMarshalRequest,
+					fmt.Errorf("failed to compress the request: %w", err))
+			}
+			release = func() {
+				c.bufferPool.Put(compressed)
 			}
+			body = bytes.NewReader(compressed.Bytes())
 		}
 	}
+	if stream != nil && !enc.encoding.IsZero() {
+		stream = encodedSource(stream, enc.encoder)
+	}
 
 	// Create the HTTP request
-	request, err := http.NewRequestWithContext(ctx, req.method, apiURL.String(), body)
+	request, err = http.NewRequestWithContext(ctx, req.method, apiURL, body)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create request: %w", err)
+		release()
+		return nil, nil, fmt.Errorf("unable to create request: %w", err)
+	}
+	if stream != nil {
+		if request.Body, err = stream(); err != nil {
+			release()
+			return nil, nil, internalError.WithErrCode(ErrCodeMarshalRequest, err)
+		}
+		// the length of a streamed body is unknown, it is sent with the chunked transfer encoding
+		request.ContentLength = -1
+		if reopenable {
+			request.GetBody = stream
+		}
 	}
 
 	// adding request headers
@@ -217,34 +425,62 @@ func (c *client) sendRequest(
 		request.Header.Set("Authorization", "Bearer "+c.apiKey)
 	}
 
-	if req.withResponse != nil && !c.contentEncoding.IsZero() {
-		request.Header.Set("Accept-Encoding", c.contentEncoding.String())
+	if (req.withResponse != nil || req.streamResponse) && c.acceptEncoding != "" {
+		request.Header.Set("Accept-Encoding", c.acceptEncoding)
 	}
 
-	if req.withRequest != nil && !c.contentEncoding.IsZero() {
-		request.Header.Set("Content-Encoding", c.contentEncoding.String())
+	if req.withRequest != nil && !enc.encoding.IsZero() {
+		request.Header.Set("Content-Encoding", enc.encoding.String())
 	}
 
 	request.Header.Set("User-Agent", GetQualifiedVersion())
+	return request, release, nil
+}
 
-	resp, err := c.do(request, internalError)
-	if err != nil {
-		return nil, err
-	}
+// marshalRequest marshals the request into a pooled buffer
+func (c *client) marshalRequest(ctx context.Context, rawRequest interface{}, internalError *Error) (*bytes.Buffer, error) {
+	buf := c.bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
 
-	if body != nil {
-		if buf, ok := body.(*bytes.Buffer); ok {
+	if b, ok := rawRequest.([]byte); ok {
+		buf.Write(b)
+	} else {
+		// Otherwise convert it to JSON
+		data, err := c.jsonCodec.Marshal(rawRequest)
+		if err != nil {
 			c.bufferPool.Put(buf)
+			return nil, internalError.WithErrCode(ErrCodeMarshalRequest,
+				fmt.Errorf("failed to marshal with the JSON codec: %w", err))
 		}
+		buf.Write(data)
 	}
-	return resp, nil
+	if buf.Len() != 0 {
+		c.logBody(ctx, "meilisearch request body", internalError, buf.Bytes())
+	}
+	return buf, nil
 }
 
 func (c *client) do(req *http.Request, internalError *Error) (resp *http.Response, err error) {
+	ctx := req.Context()
 	retriesCount := uint8(0)
+	start := time.Now()
+
+	c.log(ctx, slog.LevelDebug, "meilisearch request", internalError)
+	defer func() {
+		attrs := []slog.Attr{slog.Duration("duration", time.Since(start)), slog.Int("retries", int(retriesCount))}
+		if resp != nil {
+			attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.Any("error", err))
+		}
+		c.log(ctx, slog.LevelDebug, "meilisearch request finished", internalError, attrs...)
+	}()
 
 	for {
-		resp, err = c.client.Do(req)
+		traced, trace := c.traceRequest(req, internalError, retriesCount)
+		resp, err = c.client.Do(traced)
+		c.reportConnection(ctx, trace, resp, err)
 		if err != nil {
 			if errors.Is(err, context.DeadlineExceeded) {
 				return nil, internalError.WithErrCode(MeilisearchTimeoutError, err)
@@ -257,8 +493,9 @@ func (c *client) do(req *http.Request, internalError *Error) (resp *http.Respons
 			break
 		}
 
-		// Check if response status is retryable and we haven't exceeded max retries
-		if c.retryOnStatus[resp.StatusCode] && retriesCount < c.maxRetries {
+		// Check if response status is retryable and we haven't exceeded max retries, a body which
+		// cannot be read again cannot be retried
+		if c.retryOnStatus[resp.StatusCode] && retriesCount < c.maxRetries && canResend(req) {
 			retriesCount++
 
 			// Close response body to prevent memory leaks
@@ -268,6 +505,9 @@ func (c *client) do(req *http.Request, internalError *Error) (resp *http.Respons
 			backoff := c.retryBackoff(retriesCount)
 			timer := time.NewTimer(backoff)
 
+			c.log(ctx, slog.LevelWarn, "retrying meilisearch request", internalError,
+				slog.Int("status", resp.StatusCode), slog.Int("attempt", int(retriesCount)), slog.Duration("backoff", backoff))
+
 			select {
 			case <-req.Context().Done():
 				err := req.Context().Err()
@@ -278,6 +518,12 @@ func (c *client) do(req *http.Request, internalError *Error) (resp *http.Respons
 				timer.Stop()
 			}
 
+			if req.GetBody != nil {
+				if req.Body, err = req.GetBody(); err != nil {
+					return nil, internalError.WithErrCode(MeilisearchCommunicationError, err)
+				}
+			}
+
 			continue
 		}
 
@@ -286,12 +532,19 @@ func (c *client) do(req *http.Request, internalError *Error) (resp *http.Respons
 
 	// Return error if retries exceeded the maximum limit
 	if !c.disableRetry && retriesCount >= c.maxRetries {
+		c.log(ctx, slog.LevelWarn, "meilisearch request max retries exceeded", internalError,
+			slog.Int("status", resp.StatusCode), slog.Int("retries", int(retriesCount)))
 		return nil, internalError.WithErrCode(MeilisearchMaxRetriesExceeded, nil)
 	}
 
 	return resp, nil
 }
 
+// canResend reports whether the body of the request can be sent again
+func canResend(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
 func (c *client) handleStatusCode(req *internalRequest, statusCode int, body []byte, internalError *Error) error {
 	if req.acceptedStatusCodes != nil {
 
@@ -316,28 +569,14 @@ func (c *client) handleStatusCode(req *internalRequest, statusCode int, body []b
 
 func (c *client) handleResponse(req *internalRequest, body []byte, internalError *Error) (err error) {
 	if req.withResponse != nil {
-		if !c.contentEncoding.IsZero() {
-			if err := c.encoder.Decode(body, req.withResponse); err != nil {
-				return internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
-			}
-		} else {
-			internalError.ResponseToString = string(body)
-
-			if internalError.ResponseToString == nullBody {
-				req.withResponse = nil
-				return nil
-			}
+		if string(body) == nullBody {
+			req.withResponse = nil
+			return nil
+		}
 
-			var err error
-			if resp, ok := req.withResponse.(json.Unmarshaler); ok {
-				err = resp.UnmarshalJSON(body)
-				req.withResponse = resp
-			} else {
-				err = json.Unmarshal(body, req.withResponse)
-			}
-			if err != nil {
-				return internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
-			}
+		if err := c.jsonCodec.Unmarshal(body, req.withResponse); err != nil {
+			internalError.ResponseToString = internalError.bodyCapture.capture(internalError.Endpoint, body)
+			return internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
 		}
 	}
 	return nil
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -47,8 +49,8 @@ func Benchmark_ExecuteRequestWithEncoding(b *testing.B) {
 			accept := r.Header.Get("Accept-Encoding")
 			ce := r.Header.Get("Content-Encoding")
 
-			reqEnc := newEncoding(ContentEncoding(ce), DefaultCompression)
-			respEnc := newEncoding(ContentEncoding(accept), DefaultCompression)
+			reqEnc := newEncoding(ContentEncoding(ce), DefaultCompression, nil)
+			respEnc := newEncoding(ContentEncoding(accept), DefaultCompression, nil)
 			req := new(mockData)
 
 			if len(ce) != 0 {
@@ -72,6 +74,7 @@ func Benchmark_ExecuteRequestWithEncoding(b *testing.B) {
 				if err != nil {
 					b.Fatal(err)
 				}
+				w.Header().Set("Content-Encoding", accept)
 				_, _ = w.Write(res.Bytes())
 				w.WriteHeader(http.StatusOK)
 			}
@@ -85,6 +88,7 @@ func Benchmark_ExecuteRequestWithEncoding(b *testing.B) {
 		disableRetry:             true,
 		contentEncoding:          GzipEncoding,
 		encodingCompressionLevel: DefaultCompression,
+		acceptEncodings:          []ContentEncoding{GzipEncoding},
 	})
 
 	b.ResetTimer()
@@ -144,3 +148,117 @@ func Benchmark_ExecuteRequestWithoutRetries(b *testing.B) {
 		}
 	}
 }
+
+var benchmarkCodecs = []struct {
+	name  string
+	codec JSONCodec
+}{
+	{"std", StdJSONCodec},
+	{"easyjson", EasyJSONCodec},
+	{"funcs", NewJSONCodec(json.Marshal, json.Unmarshal)},
+}
+
+func benchmarkSearchResponse() []byte {
+	hits := make([]map[string]interface{}, 100)
+	for i := range hits {
+		hits[i] = map[string]interface{}{
+			"id":       i,
+			"title":    fmt.Sprintf("Movie %d", i),
+			"overview": strings.Repeat("lorem ipsum dolor sit amet ", 10),
+			"genres":   []string{"drama", "comedy"},
+			"_vectors": map[string]interface{}{"default": []float64{0.1, 0.2, 0.3, 0.4}},
+		}
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"hits":               hits,
+		"query":              "movie",
+		"processingTimeMs":   1,
+		"limit":              100,
+		"offset":             0,
+		"estimatedTotalHits": 1000,
+		"facetDistribution":  map[string]interface{}{"genres": map[string]int{"drama": 100, "comedy": 100}},
+	})
+	return data
+}
+
+func Benchmark_JSONCodec_Search(b *testing.B) {
+	response := benchmarkSearchResponse()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_, _ = w.Write(response)
+	}))
+	defer ts.Close()
+
+	request := &SearchRequest{
+		Limit:                100,
+		AttributesToRetrieve: []string{"id", "title", "overview", "genres"},
+		Filter:               "genres = drama",
+		Facets:               []string{"genres"},
+	}
+	for _, bc := range benchmarkCodecs {
+		b.Run(bc.name, func(b *testing.B) {
+			index := New(ts.URL, WithJSONCodec(bc.codec), DisableRetries()).Index("movies")
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := index.Search("movie", request); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func Benchmark_JSONCodec_AddDocuments(b *testing.B) {
+	type movie struct {
+		ID       int      `json:"id"`
+		Title    string   `json:"title"`
+		Overview string   `json:"overview"`
+		Genres   []string `json:"genres"`
+	}
+	documents := make([]movie, 1000)
+	for i := range documents {
+		documents[i] = movie{
+			ID:       i,
+			Title:    fmt.Sprintf("Movie %d", i),
+			Overview: strings.Repeat("lorem ipsum dolor sit amet ", 10),
+			Genres:   []string{"drama", "comedy"},
+		}
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1,"indexUid":"movies","status":"enqueued","type":"documentAdditionOrUpdate"}`))
+	}))
+	defer ts.Close()
+
+	for _, bc := range benchmarkCodecs {
+		b.Run(bc.name, func(b *testing.B) {
+			index := New(ts.URL, WithJSONCodec(bc.codec), DisableRetries()).Index("movies")
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := index.AddDocuments(documents); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func Benchmark_JSONCodec_Unmarshal(b *testing.B) {
+	response := benchmarkSearchResponse()
+	for _, bc := range benchmarkCodecs {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(response)))
+			for i := 0; i < b.N; i++ {
+				resp := new(SearchResponse)
+				if err := bc.codec.Unmarshal(response, resp); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
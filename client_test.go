@@ -37,7 +37,7 @@ func TestExecuteRequest(t *testing.T) {
 		} else if r.Method == http.MethodGet && r.URL.Path == "/test-get-encoding" {
 			encode := r.Header.Get("Accept-Encoding")
 			if len(encode) != 0 {
-				enc := newEncoding(ContentEncoding(encode), DefaultCompression)
+				enc := newEncoding(ContentEncoding(encode), DefaultCompression, nil)
 				d := &mockData{Name: "foo", Age: 30}
 
 				b, err := json.Marshal(d)
@@ -45,6 +45,7 @@ func TestExecuteRequest(t *testing.T) {
 
 				res, err := enc.Encode(bytes.NewReader(b))
 				require.NoError(t, err)
+				w.Header().Set("Content-Encoding", encode)
 				_, _ = w.Write(res.Bytes())
 				w.WriteHeader(http.StatusOK)
 				return
@@ -55,8 +56,8 @@ func TestExecuteRequest(t *testing.T) {
 			accept := r.Header.Get("Accept-Encoding")
 			ce := r.Header.Get("Content-Encoding")
 
-			reqEnc := newEncoding(ContentEncoding(ce), DefaultCompression)
-			respEnc := newEncoding(ContentEncoding(accept), DefaultCompression)
+			reqEnc := newEncoding(ContentEncoding(ce), DefaultCompression, nil)
+			respEnc := newEncoding(ContentEncoding(accept), DefaultCompression, nil)
 			req := new(mockData)
 
 			if len(ce) != 0 {
@@ -72,6 +73,7 @@ func TestExecuteRequest(t *testing.T) {
 				require.NoError(t, err)
 				res, err := respEnc.Encode(bytes.NewReader(d))
 				require.NoError(t, err)
+				w.Header().Set("Content-Encoding", accept)
 				_, _ = w.Write(res.Bytes())
 				w.WriteHeader(http.StatusOK)
 			}
@@ -85,17 +87,19 @@ func TestExecuteRequest(t *testing.T) {
 			msg := []byte(`null`)
 			_, _ = w.Write(msg)
 		} else if r.Method == http.MethodPost && r.URL.Path == "/test-post-encoding" {
-			w.WriteHeader(http.StatusCreated)
 			msg := []byte(`{"message":"post successful"}`)
 
 			enc := r.Header.Get("Accept-Encoding")
 			if len(enc) != 0 {
-				e := newEncoding(ContentEncoding(enc), DefaultCompression)
+				e := newEncoding(ContentEncoding(enc), DefaultCompression, nil)
 				b, err := e.Encode(bytes.NewReader(msg))
 				require.NoError(t, err)
+				w.Header().Set("Content-Encoding", enc)
+				w.WriteHeader(http.StatusCreated)
 				_, _ = w.Write(b.Bytes())
 				return
 			}
+			w.WriteHeader(http.StatusCreated)
 			_, _ = w.Write(msg)
 		} else if r.URL.Path == "/test-bad-request" {
 			w.WriteHeader(http.StatusBadRequest)
@@ -429,6 +433,7 @@ func TestExecuteRequest(t *testing.T) {
 			c := newClient(&http.Client{}, ts.URL, "testApiKey", clientConfig{
 				contentEncoding:          tt.contentEncoding,
 				encodingCompressionLevel: DefaultCompression,
+				acceptEncodings:          []ContentEncoding{tt.contentEncoding},
 				maxRetries:               3,
 				disableRetry:             tt.disableRetry,
 				retryOnStatus: map[int]bool{
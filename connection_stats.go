@@ -0,0 +1,130 @@
+package meilisearch
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// ConnectionStats describes the connection of an attempt of a request, see WithConnectionStats
+type ConnectionStats struct {
+	Function string
+	Method   string
+	Endpoint string
+	// Attempt is 0 for the first attempt and the number of the retry afterward
+	Attempt uint8
+	// Reused reports whether the connection was reused from the pool instead of dialed
+	Reused bool
+	// WasIdle reports whether the reused connection was idle and IdleTime for how long
+	WasIdle  bool
+	IdleTime time.Duration
+	// Protocol is the protocol of the response, eg. "HTTP/2.0", empty when the request failed
+	Protocol   string
+	RemoteAddr string
+
+	// DNS, Connect and TLSHandshake are zero when the connection is reused
+	DNS          time.Duration
+	Connect      time.Duration
+	TLSHandshake time.Duration
+	// TimeToFirstByte is the time from the start of the attempt to the first byte of the response
+	TimeToFirstByte time.Duration
+	// Err is the error of the attempt, if any
+	Err error
+}
+
+// connectionTrace records the connection events of an attempt, dialing may happen in parallel
+// when several addresses are tried
+type connectionTrace struct {
+	mu       sync.Mutex
+	start    time.Time
+	stats    ConnectionStats
+	dnsStart time.Time
+	dialing  map[string]time.Time
+	tlsStart time.Time
+}
+
+// traceRequest returns the request tracing its connection when the connection stats are enabled
+func (c *client) traceRequest(req *http.Request, internalError *Error, attempt uint8) (*http.Request, *connectionTrace) {
+	if c.connectionStats == nil {
+		return req, nil
+	}
+
+	t := &connectionTrace{
+		start:   time.Now(),
+		dialing: map[string]time.Time{},
+		stats: ConnectionStats{
+			Function: internalError.Function,
+			Method:   internalError.Method,
+			Endpoint: internalError.Endpoint,
+			Attempt:  attempt,
+		},
+	}
+	trace := &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mu.Lock()
+			t.dnsStart = time.Now()
+			t.mu.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mu.Lock()
+			t.stats.DNS = time.Since(t.dnsStart)
+			t.mu.Unlock()
+		},
+		ConnectStart: func(_, addr string) {
+			t.mu.Lock()
+			t.dialing[addr] = time.Now()
+			t.mu.Unlock()
+		},
+		ConnectDone: func(_, addr string, err error) {
+			t.mu.Lock()
+			if err == nil {
+				t.stats.Connect = time.Since(t.dialing[addr])
+			}
+			t.mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			t.tlsStart = time.Now()
+			t.mu.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mu.Lock()
+			t.stats.TLSHandshake = time.Since(t.tlsStart)
+			t.mu.Unlock()
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.stats.Reused = info.Reused
+			t.stats.WasIdle = info.WasIdle
+			t.stats.IdleTime = info.IdleTime
+			if info.Conn != nil && info.Conn.RemoteAddr() != nil {
+				t.stats.RemoteAddr = info.Conn.RemoteAddr().String()
+			}
+			t.mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			t.stats.TimeToFirstByte = time.Since(t.start)
+			t.mu.Unlock()
+		},
+	}
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace)), t
+}
+
+// reportConnection passes the stats of the traced attempt to the callback of WithConnectionStats
+func (c *client) reportConnection(ctx context.Context, t *connectionTrace, resp *http.Response, err error) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	stats := t.stats
+	t.mu.Unlock()
+	if resp != nil {
+		stats.Protocol = resp.Proto
+	}
+	stats.Err = err
+	c.connectionStats(ctx, stats)
+}
@@ -0,0 +1,59 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithConnectionStats(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"available"}`))
+	}))
+	defer ts.Close()
+
+	var stats []ConnectionStats
+	sm := New(ts.URL, WithConnectionStats(func(_ context.Context, s ConnectionStats) {
+		stats = append(stats, s)
+	}))
+	sm.(*meilisearch).client.retryBackoff = func(uint8) time.Duration { return time.Millisecond }
+
+	_, err := sm.Health()
+	require.NoError(t, err)
+	_, err = sm.Health()
+	require.NoError(t, err)
+
+	require.Len(t, stats, 3)
+	require.Equal(t, "Health", stats[0].Function)
+	require.Equal(t, http.MethodGet, stats[0].Method)
+	require.Equal(t, "/health", stats[0].Endpoint)
+	require.False(t, stats[0].Reused)
+	require.NotZero(t, stats[0].Connect)
+	require.Equal(t, "HTTP/1.1", stats[0].Protocol)
+	require.Equal(t, ts.Listener.Addr().String(), stats[0].RemoteAddr)
+	require.NotZero(t, stats[0].TimeToFirstByte)
+
+	require.True(t, stats[1].Reused)
+	require.True(t, stats[1].WasIdle)
+	require.Zero(t, stats[1].Connect)
+	require.Equal(t, uint8(0), stats[1].Attempt)
+	require.Equal(t, uint8(1), stats[2].Attempt)
+	require.True(t, stats[2].Reused)
+
+	ts.Close()
+	_, err = sm.Health()
+	require.Error(t, err)
+	require.Len(t, stats, 4)
+	require.Error(t, stats[3].Err)
+	require.Empty(t, stats[3].Protocol)
+}
@@ -0,0 +1,264 @@
+package meilisearch
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultEditDryRunSampleSize is the number of documents edited by DryRunEditDocuments when no
+// sample size is given
+const DefaultEditDryRunSampleSize int64 = 20
+
+// EditFunction builds the Rhai function of an UpdateDocumentsByFunction request from common edits.
+// Values are given to the function through the Context of the request with Param and are read
+// with `context.<name>` instead of being interpolated in the function.
+//
+//	fn := meilisearch.NewEditFunction().
+//		Filter("genres = horror").
+//		RenameField("name", "title").
+//		Lowercase("title").
+//		Param("rate", 1.2).
+//		ComputeField("price", "doc.price * context.rate").
+//		DeleteWhen("doc.stock == 0")
+//
+// DryRunEditDocuments previews the function on a sample copied into a scratch index created and deleted
+// on the server, the API key must be allowed to create and delete indexes.
+//
+// Documentation: https://www.meilisearch.com/docs/reference/api/documents#update-documents-with-function
+type EditFunction struct {
+	filter     string
+	statements []string
+	context    map[string]interface{}
+	errs       []error
+}
+
+// NewEditFunction returns an empty EditFunction
+func NewEditFunction() *EditFunction {
+	return &EditFunction{context: map[string]interface{}{}}
+}
+
+func (f *EditFunction) field(path string) string {
+	names := strings.Split(path, ".")
+	for _, name := range names {
+		if name == "" {
+			f.errs = append(f.errs, fmt.Errorf("%w: invalid field %q", ErrInvalidEditFunction, path))
+			break
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("doc")
+	for _, name := range names {
+		b.WriteString("[" + rhaiString(name) + "]")
+	}
+	return b.String()
+}
+
+// Filter restricts the edit to the documents matching filter
+func (f *EditFunction) Filter(filter string) *EditFunction {
+	f.filter = filter
+	return f
+}
+
+// Param adds a value to the Context of the request, read in expressions as `context.<name>`
+func (f *EditFunction) Param(name string, value interface{}) *EditFunction {
+	if !isRhaiIdentifier(name) {
+		f.errs = append(f.errs, fmt.Errorf("%w: invalid parameter name %q", ErrInvalidEditFunction, name))
+	}
+	f.context[name] = value
+	return f
+}
+
+// RenameField moves the value of the top-level field from to the field to
+func (f *EditFunction) RenameField(from, to string) *EditFunction {
+	if from == "" || to == "" || strings.Contains(from, ".") || strings.Contains(to, ".") {
+		f.errs = append(f.errs, fmt.Errorf("%w: can not rename %q to %q, only top-level fields can be renamed",
+			ErrInvalidEditFunction, from, to))
+	}
+	return f.Statement(fmt.Sprintf("if doc.contains(%s) { doc[%s] = doc.remove(%s); }",
+		rhaiString(from), rhaiString(to), rhaiString(from)))
+}
+
+// Lowercase converts the string field to lower case
+func (f *EditFunction) Lowercase(field string) *EditFunction {
+	v := f.field(field)
+	return f.Statement(fmt.Sprintf("if type_of(%s) == \"string\" { %s = %s.to_lower(); }", v, v, v))
+}
+
+// Uppercase converts the string field to upper case
+func (f *EditFunction) Uppercase(field string) *EditFunction {
+	v := f.field(field)
+	return f.Statement(fmt.Sprintf("if type_of(%s) == \"string\" { %s = %s.to_upper(); }", v, v, v))
+}
+
+// ComputeField sets the field to the result of a Rhai expression, eg. `doc.price * context.rate`
+func (f *EditFunction) ComputeField(field, expression string) *EditFunction {
+	return f.Statement(fmt.Sprintf("%s = %s;", f.field(field), expression))
+}
+
+// DeleteWhen deletes the documents for which the Rhai predicate is true, eg. `doc.stock == 0`.
+// The statements added after it are not run on the deleted documents.
+func (f *EditFunction) DeleteWhen(predicate string) *EditFunction {
+	return f.Statement(fmt.Sprintf("if %s { doc = (); return; }", predicate))
+}
+
+// Statement adds a raw Rhai statement to the function
+func (f *EditFunction) Statement(statement string) *EditFunction {
+	if err := checkRhaiSyntax(statement); err != nil {
+		f.errs = append(f.errs, err)
+	}
+	f.statements = append(f.statements, statement)
+	return f
+}
+
+// Request validates the function and returns the request to give to UpdateDocumentsByFunction
+func (f *EditFunction) Request() (*UpdateDocumentByFunctionRequest, error) {
+	if f == nil || len(f.statements) == 0 {
+		return nil, fmt.Errorf("%w: no statement", ErrInvalidEditFunction)
+	}
+	if len(f.errs) != 0 {
+		return nil, f.errs[0]
+	}
+
+	req := &UpdateDocumentByFunctionRequest{
+		Filter:   f.filter,
+		Function: strings.Join(f.statements, "\n"),
+	}
+	if len(f.context) != 0 {
+		req.Context = f.context
+	}
+	return req, nil
+}
+
+func isRhaiIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(i > 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// checkRhaiSyntax catches the common mistakes in a Rhai statement before it is sent: unbalanced
+// brackets and unterminated strings
+func checkRhaiSyntax(src string) error {
+	var stack []rune
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+	for i := 0; i < len(src); i++ {
+		c := rune(src[i])
+		switch c {
+		case '"', '`', '\'':
+			end := i + 1
+			for ; end < len(src) && rune(src[end]) != c; end++ {
+				if src[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(src) {
+				return fmt.Errorf("%w: unterminated string in %q", ErrInvalidEditFunction, src)
+			}
+			i = end
+		case '/':
+			if i+1 < len(src) && src[i+1] == '/' {
+				for i < len(src) && src[i] != '\n' {
+					i++
+				}
+			}
+		case '(', '[', '{':
+			stack = append(stack, c)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != closing[c] {
+				return fmt.Errorf("%w: unexpected %q in %q", ErrInvalidEditFunction, c, src)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if len(stack) != 0 {
+		return fmt.Errorf("%w: unclosed %q in %q", ErrInvalidEditFunction, stack[len(stack)-1], src)
+	}
+	return nil
+}
+
+// EditDryRunResult is the outcome of DryRunEditDocuments
+type EditDryRunResult struct {
+	// Request is the request that would be sent by EditDocuments
+	Request *UpdateDocumentByFunctionRequest
+	// Sampled is the number of documents the function was applied to
+	Sampled int
+	// Diffs lists the sampled documents changed by the function
+	Diffs []DocumentDiff
+}
+
+// DocumentDiff describes how a document is changed by an edit function
+type DocumentDiff struct {
+	ID      string
+	Deleted bool
+	Changes []FieldChange
+}
+
+// FieldChange is the change of a top-level field, Before is nil for an added field and After is
+// nil for a removed field
+type FieldChange struct {
+	Field  string
+	Before interface{}
+	After  interface{}
+}
+
+func diffDocuments(primaryKey string, before, after []map[string]interface{}) []DocumentDiff {
+	edited := make(map[string]map[string]interface{}, len(after))
+	for _, doc := range after {
+		edited[documentID(doc[primaryKey])] = doc
+	}
+
+	var diffs []DocumentDiff
+	for _, doc := range before {
+		id := documentID(doc[primaryKey])
+		newDoc, ok := edited[id]
+		if !ok {
+			diffs = append(diffs, DocumentDiff{ID: id, Deleted: true})
+			continue
+		}
+
+		fields := make(map[string]bool, len(doc)+len(newDoc))
+		for name := range doc {
+			fields[name] = true
+		}
+		for name := range newDoc {
+			fields[name] = true
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		diff := DocumentDiff{ID: id}
+		for _, name := range names {
+			if !reflect.DeepEqual(doc[name], newDoc[name]) {
+				diff.Changes = append(diff.Changes, FieldChange{Field: name, Before: doc[name], After: newDoc[name]})
+			}
+		}
+		if len(diff.Changes) != 0 {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
+
+func documentID(v interface{}) string {
+	switch id := v.(type) {
+	case string:
+		return id
+	case float64:
+		return strconv.FormatFloat(id, 'f', -1, 64)
+	default:
+		return fmt.Sprint(id)
+	}
+}
@@ -0,0 +1,205 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditFunction_Request(t *testing.T) {
+	req, err := NewEditFunction().
+		Filter("genres = horror").
+		RenameField("name", "title").
+		Lowercase("title").
+		Uppercase("info.code").
+		Param("rate", 1.2).
+		ComputeField("price", "doc.price * context.rate").
+		DeleteWhen("doc.stock == 0").
+		Request()
+	require.NoError(t, err)
+	require.Equal(t, &UpdateDocumentByFunctionRequest{
+		Filter: "genres = horror",
+		Function: `if doc.contains("name") { doc["title"] = doc.remove("name"); }
+if type_of(doc["title"]) == "string" { doc["title"] = doc["title"].to_lower(); }
+if type_of(doc["info"]["code"]) == "string" { doc["info"]["code"] = doc["info"]["code"].to_upper(); }
+doc["price"] = doc.price * context.rate;
+if doc.stock == 0 { doc = (); return; }`,
+		Context: map[string]interface{}{"rate": 1.2},
+	}, req)
+}
+
+func TestEditFunction_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   *EditFunction
+	}{
+		{name: "empty", fn: NewEditFunction()},
+		{name: "invalid param", fn: NewEditFunction().Param("1rate", 1).Lowercase("title")},
+		{name: "nested rename", fn: NewEditFunction().RenameField("info.name", "title")},
+		{name: "invalid field", fn: NewEditFunction().Lowercase("info..name")},
+		{name: "unbalanced", fn: NewEditFunction().ComputeField("price", "(doc.price * 2")},
+		{name: "mismatched", fn: NewEditFunction().DeleteWhen("doc.tags[0) == 1")},
+		{name: "unterminated string", fn: NewEditFunction().Statement(`doc.title = "abc;`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.fn.Request()
+			require.ErrorIs(t, err, ErrInvalidEditFunction)
+		})
+	}
+
+	// brackets in strings and comments are ignored
+	_, err := NewEditFunction().Statement(`doc.title = "(" + '}'; // [`).Request()
+	require.NoError(t, err)
+}
+
+func TestDiffDocuments(t *testing.T) {
+	before := []map[string]interface{}{
+		{"id": float64(1), "title": "Carol", "stock": float64(2)},
+		{"id": float64(2), "title": "Dune", "stock": float64(0)},
+		{"id": float64(3), "title": "Pi", "stock": float64(5)},
+	}
+	after := []map[string]interface{}{
+		{"id": float64(1), "title": "carol", "stock": float64(2), "new": true},
+		{"id": float64(3), "title": "Pi", "stock": float64(5)},
+	}
+
+	require.Equal(t, []DocumentDiff{
+		{ID: "1", Changes: []FieldChange{
+			{Field: "new", After: true},
+			{Field: "title", Before: "Carol", After: "carol"},
+		}},
+		{ID: "2", Deleted: true},
+	}, diffDocuments("id", before, after))
+}
+
+func TestIndex_EditDocuments(t *testing.T) {
+	var mu sync.Mutex
+	enabled := false
+	scratch := map[string][]map[string]interface{}{}
+	deleted := []string{}
+	filterable := map[string][]string{}
+	failDelete := false
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		write := func(status int, v interface{}) {
+			b, err := json.Marshal(v)
+			require.NoError(t, err)
+			w.WriteHeader(status)
+			_, _ = w.Write(b)
+		}
+		task := map[string]interface{}{"taskUid": 1, "status": "enqueued"}
+
+		switch {
+		case r.URL.Path == "/experimental-features":
+			write(http.StatusOK, map[string]interface{}{"editDocumentsByFunction": enabled})
+		case r.URL.Path == "/tasks/1":
+			write(http.StatusOK, map[string]interface{}{"uid": 1, "status": "succeeded"})
+		case r.URL.Path == "/indexes/movies":
+			write(http.StatusOK, map[string]interface{}{"uid": "movies", "primaryKey": "id"})
+		case r.URL.Path == "/indexes/movies/settings/filterable-attributes":
+			write(http.StatusOK, []string{"stock"})
+		case r.URL.Path == "/indexes/movies/documents/fetch":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `{"limit": 2, "filter": "stock < 3"}`, string(body))
+			write(http.StatusOK, map[string]interface{}{"results": []map[string]interface{}{
+				{"id": 1, "title": "Carol", "stock": 2},
+				{"id": 2, "title": "Dune", "stock": 0},
+			}})
+		case r.URL.Path == "/indexes/movies/documents/edit":
+			write(http.StatusAccepted, task)
+		case strings.HasPrefix(r.URL.Path, "/indexes/movies-dry-run-"):
+			uid := strings.Split(r.URL.Path, "/")[2]
+			switch {
+			case r.Method == http.MethodDelete:
+				if failDelete {
+					write(http.StatusForbidden, map[string]interface{}{"message": "forbidden", "code": "invalid_api_key"})
+					return
+				}
+				deleted = append(deleted, uid)
+				write(http.StatusAccepted, task)
+			case strings.HasSuffix(r.URL.Path, "/settings/filterable-attributes"):
+				var attributes []string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&attributes))
+				require.Empty(t, scratch[uid], "settings are applied before the documents")
+				filterable[uid] = attributes
+				write(http.StatusAccepted, task)
+			case strings.HasSuffix(r.URL.Path, "/documents") && r.Method == http.MethodPost:
+				require.Equal(t, "id", r.URL.Query().Get("primaryKey"))
+				var docs []map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&docs))
+				scratch[uid] = docs
+				write(http.StatusAccepted, task)
+			case strings.HasSuffix(r.URL.Path, "/documents/edit"):
+				var req UpdateDocumentByFunctionRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				require.Empty(t, req.Filter)
+				// emulates `lowercase title` and `delete when stock == 0`
+				var kept []map[string]interface{}
+				for _, doc := range scratch[uid] {
+					if doc["stock"] == float64(0) {
+						continue
+					}
+					doc["title"] = strings.ToLower(doc["title"].(string))
+					kept = append(kept, doc)
+				}
+				scratch[uid] = kept
+				write(http.StatusAccepted, task)
+			case strings.HasSuffix(r.URL.Path, "/documents"):
+				write(http.StatusOK, map[string]interface{}{"results": scratch[uid]})
+			}
+		default:
+			write(http.StatusNotFound, map[string]interface{}{"message": "not found"})
+		}
+	}))
+	defer ts.Close()
+
+	idx := New(ts.URL).Index("movies")
+	fn := NewEditFunction().Filter("stock < 3").Lowercase("title").DeleteWhen("doc.stock == 0")
+
+	_, err := idx.EditDocuments(fn)
+	require.ErrorIs(t, err, ErrExperimentalFeatureDisabled)
+
+	_, err = idx.DryRunEditDocuments(fn, 2)
+	require.ErrorIs(t, err, ErrExperimentalFeatureDisabled)
+
+	enabled = true
+	task, err := idx.EditDocuments(fn)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), task.TaskUID)
+
+	res, err := idx.DryRunEditDocuments(fn, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Sampled)
+	require.Equal(t, "stock < 3", res.Request.Filter)
+	require.Equal(t, []DocumentDiff{
+		{ID: "1", Changes: []FieldChange{{Field: "title", Before: "Carol", After: "carol"}}},
+		{ID: "2", Deleted: true},
+	}, res.Diffs)
+
+	// the scratch index has the filterable attributes and is removed
+	require.Len(t, deleted, 1)
+	require.Contains(t, scratch, deleted[0])
+	require.Equal(t, []string{"stock"}, filterable[deleted[0]])
+
+	// a scratch index which cannot be deleted is reported
+	mu.Lock()
+	failDelete = true
+	mu.Unlock()
+	res, err = idx.DryRunEditDocuments(fn, 2)
+	require.ErrorIs(t, err, ErrEditDryRunCleanup)
+	require.ErrorIs(t, err, APIErrorCodeInvalidAPIKey)
+	require.Len(t, res.Diffs, 2)
+	require.Len(t, deleted, 1)
+}
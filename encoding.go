@@ -4,21 +4,32 @@ import (
 	"bytes"
 	"compress/gzip"
 	"compress/zlib"
-	"encoding/json"
+	"context"
+	"errors"
+	"fmt"
 	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 	"io"
+	"log/slog"
+	"net/http"
+	"strings"
 	"sync"
 )
 
 type encoder interface {
 	Encode(rc io.Reader) (*bytes.Buffer, error)
+	// EncodeTo compresses what is read from rc into w while it is read
+	EncodeTo(w io.Writer, rc io.Reader) error
 	Decode(data []byte, vPtr interface{}) error
 }
 
-func newEncoding(ce ContentEncoding, level EncodingCompressionLevel) encoder {
+// newEncoding returns the encoder of ce, Decode unmarshals with codec or the default codec when it is nil
+func newEncoding(ce ContentEncoding, level EncodingCompressionLevel, codec JSONCodec) encoder {
+	codec = codecOrDefault(codec)
 	switch ce {
 	case GzipEncoding:
 		return &gzipEncoder{
+			codec: codec,
 			gzWriterPool: &sync.Pool{
 				New: func() interface{} {
 					w, err := gzip.NewWriterLevel(io.Discard, level.Int())
@@ -36,6 +47,7 @@ func newEncoding(ce ContentEncoding, level EncodingCompressionLevel) encoder {
 		}
 	case DeflateEncoding:
 		return &flateEncoder{
+			codec: codec,
 			flWriterPool: &sync.Pool{
 				New: func() interface{} {
 					w, err := zlib.NewWriterLevel(io.Discard, level.Int())
@@ -53,6 +65,7 @@ func newEncoding(ce ContentEncoding, level EncodingCompressionLevel) encoder {
 		}
 	case BrotliEncoding:
 		return &brotliEncoder{
+			codec: codec,
 			brWriterPool: &sync.Pool{
 				New: func() interface{} {
 					return brotli.NewWriterLevel(io.Discard, level.Int())
@@ -64,14 +77,96 @@ func newEncoding(ce ContentEncoding, level EncodingCompressionLevel) encoder {
 				},
 			},
 		}
+	case ZstdEncoding:
+		return &zstdEncoder{
+			codec: codec,
+			zWriterPool: &sync.Pool{
+				New: func() interface{} {
+					w, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstdLevel(level)), zstd.WithEncoderConcurrency(1))
+					return &zstdWriter{
+						writer: w,
+						err:    err,
+					}
+				},
+			},
+			zReaderPool: &sync.Pool{
+				New: func() interface{} {
+					r, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+					return &zstdReader{
+						reader: r,
+						err:    err,
+					}
+				},
+			},
+			bufferPool: &sync.Pool{
+				New: func() interface{} {
+					return new(bytes.Buffer)
+				},
+			},
+		}
 	default:
 		return nil
 	}
 }
 
+// zstdLevel maps the compression levels of compress/flate to the zstd encoder levels
+func zstdLevel(level EncodingCompressionLevel) zstd.EncoderLevel {
+	switch {
+	case level == DefaultCompression:
+		return zstd.SpeedDefault
+	case level <= BestSpeed:
+		return zstd.SpeedFastest
+	case level <= 5:
+		return zstd.SpeedDefault
+	case level < BestCompression:
+		return zstd.SpeedBetterCompression
+	default:
+		return zstd.SpeedBestCompression
+	}
+}
+
+// requestEncoding is the encoding of the request bodies, encoder is nil when encoding is empty
+type requestEncoding struct {
+	encoding ContentEncoding
+	encoder  encoder
+}
+
+// supportedEncodings are the content encodings the client can compress with, from the preferred one
+var supportedEncodings = []ContentEncoding{ZstdEncoding, BrotliEncoding, GzipEncoding, DeflateEncoding}
+
+// fallbackEncoding is called when meilisearch answers 415 Unsupported Media Type to a request compressed
+// with current: it returns the first encoding of the Accept-Encoding header of the response the client
+// supports, or no encoding, and uses it for the next requests.
+func (c *client) fallbackEncoding(ctx context.Context, current *requestEncoding, resp *http.Response, internalError *Error) *requestEncoding {
+	fallback := &requestEncoding{}
+	accepted := map[ContentEncoding]bool{}
+	for _, value := range strings.Split(resp.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(value), ";")
+		if strings.ReplaceAll(strings.TrimSpace(params), " ", "") == "q=0" {
+			continue
+		}
+		accepted[ContentEncoding(strings.ToLower(name))] = true
+	}
+	for _, encoding := range supportedEncodings {
+		if encoding != current.encoding && accepted[encoding] {
+			fallback = &requestEncoding{encoding: encoding, encoder: newEncoding(encoding, c.compressionLevel, c.jsonCodec)}
+			break
+		}
+	}
+
+	if !c.requestEncoding.CompareAndSwap(current, fallback) {
+		// another request already fell back
+		fallback = c.requestEncoding.Load()
+	}
+	c.log(ctx, slog.LevelWarn, "meilisearch does not accept the content encoding, falling back", internalError,
+		slog.String("encoding", current.encoding.String()), slog.String("fallback", fallback.encoding.String()))
+	return fallback
+}
+
 type gzipEncoder struct {
 	gzWriterPool *sync.Pool
 	bufferPool   *sync.Pool
+	codec        JSONCodec
 }
 
 type gzipWriter struct {
@@ -104,6 +199,21 @@ func (g *gzipEncoder) Encode(rc io.Reader) (*bytes.Buffer, error) {
 	return buf, nil
 }
 
+func (g *gzipEncoder) EncodeTo(w io.Writer, rc io.Reader) error {
+	gw := g.gzWriterPool.Get().(*gzipWriter)
+	defer g.gzWriterPool.Put(gw)
+
+	if gw.err != nil {
+		return gw.err
+	}
+
+	gw.writer.Reset(w)
+	if _, err := copyZeroAlloc(gw.writer, rc); err != nil {
+		return err
+	}
+	return gw.writer.Close()
+}
+
 func (g *gzipEncoder) Decode(data []byte, vPtr interface{}) error {
 	r, err := gzip.NewReader(bytes.NewReader(data))
 	if err != nil {
@@ -113,16 +223,13 @@ func (g *gzipEncoder) Decode(data []byte, vPtr interface{}) error {
 		_ = r.Close()
 	}()
 
-	if err := json.NewDecoder(r).Decode(vPtr); err != nil {
-		return err
-	}
-
-	return nil
+	return decodeJSON(r, g.codec, vPtr)
 }
 
 type flateEncoder struct {
 	flWriterPool *sync.Pool
 	bufferPool   *sync.Pool
+	codec        JSONCodec
 }
 
 type flateWriter struct {
@@ -153,6 +260,21 @@ func (d *flateEncoder) Encode(rc io.Reader) (*bytes.Buffer, error) {
 	return buf, nil
 }
 
+func (d *flateEncoder) EncodeTo(w io.Writer, rc io.Reader) error {
+	fw := d.flWriterPool.Get().(*flateWriter)
+	defer d.flWriterPool.Put(fw)
+
+	if fw.err != nil {
+		return fw.err
+	}
+
+	fw.writer.Reset(w)
+	if _, err := copyZeroAlloc(fw.writer, rc); err != nil {
+		return err
+	}
+	return fw.writer.Close()
+}
+
 func (d *flateEncoder) Decode(data []byte, vPtr interface{}) error {
 	r, err := zlib.NewReader(bytes.NewBuffer(data))
 	if err != nil {
@@ -163,16 +285,13 @@ func (d *flateEncoder) Decode(data []byte, vPtr interface{}) error {
 		_ = r.Close()
 	}()
 
-	if err := json.NewDecoder(r).Decode(vPtr); err != nil {
-		return err
-	}
-
-	return nil
+	return decodeJSON(r, d.codec, vPtr)
 }
 
 type brotliEncoder struct {
 	brWriterPool *sync.Pool
 	bufferPool   *sync.Pool
+	codec        JSONCodec
 }
 
 func (b *brotliEncoder) Encode(rc io.Reader) (*bytes.Buffer, error) {
@@ -193,15 +312,155 @@ func (b *brotliEncoder) Encode(rc io.Reader) (*bytes.Buffer, error) {
 	return buf, nil
 }
 
+func (b *brotliEncoder) EncodeTo(w io.Writer, rc io.Reader) error {
+	bw := b.brWriterPool.Get().(*brotli.Writer)
+	defer b.brWriterPool.Put(bw)
+
+	bw.Reset(w)
+	if _, err := copyZeroAlloc(bw, rc); err != nil {
+		return err
+	}
+	return bw.Close()
+}
+
 func (b *brotliEncoder) Decode(data []byte, vPtr interface{}) error {
 	r := brotli.NewReader(bytes.NewBuffer(data))
-	if err := json.NewDecoder(r).Decode(vPtr); err != nil {
+	return decodeJSON(r, b.codec, vPtr)
+}
+
+type zstdEncoder struct {
+	zWriterPool *sync.Pool
+	zReaderPool *sync.Pool
+	bufferPool  *sync.Pool
+	codec       JSONCodec
+}
+
+type zstdWriter struct {
+	writer *zstd.Encoder
+	err    error
+}
+
+type zstdReader struct {
+	reader *zstd.Decoder
+	err    error
+}
+
+func (z *zstdEncoder) Encode(rc io.Reader) (*bytes.Buffer, error) {
+	buf := z.bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	if err := z.EncodeTo(buf, rc); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+func (z *zstdEncoder) EncodeTo(w io.Writer, rc io.Reader) error {
+	zw := z.zWriterPool.Get().(*zstdWriter)
+	defer z.zWriterPool.Put(zw)
+
+	if zw.err != nil {
+		return zw.err
+	}
+
+	zw.writer.Reset(w)
+	if _, err := copyZeroAlloc(zw.writer, rc); err != nil {
 		return err
 	}
+	return zw.writer.Close()
+}
+
+func (z *zstdEncoder) Decode(data []byte, vPtr interface{}) error {
+	zr := z.zReaderPool.Get().(*zstdReader)
+	defer z.zReaderPool.Put(zr)
+
+	if zr.err != nil {
+		return zr.err
+	}
 
+	if err := zr.reader.Reset(bytes.NewReader(data)); err != nil {
+		return err
+	}
+	defer func() {
+		// release the reference to data
+		_ = zr.reader.Reset(nil)
+	}()
+
+	return decodeJSON(zr.reader, z.codec, vPtr)
+}
+
+// decodeJSON reads the decompressed body and unmarshals it with codec
+func decodeJSON(r io.Reader, codec JSONCodec, vPtr interface{}) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return codec.Unmarshal(data, vPtr)
+}
+
+// zstdDecoderPool holds the decoders of the zstd responses decompressed while they are read
+var zstdDecoderPool = sync.Pool{
+	New: func() interface{} {
+		r, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		return &zstdReader{
+			reader: r,
+			err:    err,
+		}
+	},
+}
+
+// pooledZstdReader gives its decoder back to zstdDecoderPool when it is closed
+type pooledZstdReader struct {
+	*zstdReader
+}
+
+func (r pooledZstdReader) Read(p []byte) (int, error) {
+	return r.reader.Read(p)
+}
+
+func (r pooledZstdReader) Close() error {
+	_ = r.reader.Reset(nil)
+	zstdDecoderPool.Put(r.zstdReader)
 	return nil
 }
 
+// decompressBody returns a reader decompressing the body according to the Content-Encoding of the
+// response, the body is returned as is when the response is not compressed
+func decompressBody(resp *http.Response) (io.ReadCloser, error) {
+	var (
+		r   io.ReadCloser
+		err error
+	)
+	switch ContentEncoding(strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))) {
+	case "", "identity":
+		return resp.Body, nil
+	case GzipEncoding:
+		r, err = gzip.NewReader(resp.Body)
+	case DeflateEncoding:
+		r, err = zlib.NewReader(resp.Body)
+	case BrotliEncoding:
+		r = io.NopCloser(brotli.NewReader(resp.Body))
+	case ZstdEncoding:
+		zr := zstdDecoderPool.Get().(*zstdReader)
+		if zr.err != nil {
+			return nil, zr.err
+		}
+		if err = zr.reader.Reset(resp.Body); err != nil {
+			zstdDecoderPool.Put(zr)
+			return nil, err
+		}
+		r = pooledZstdReader{zstdReader: zr}
+	default:
+		return nil, fmt.Errorf("unsupported response Content-Encoding %q", resp.Header.Get("Content-Encoding"))
+	}
+	if errors.Is(err, io.EOF) {
+		// the gzip and zlib readers read the header of the empty bodies
+		return http.NoBody, nil
+	}
+	return r, err
+}
+
 var copyBufPool = sync.Pool{
 	New: func() interface{} {
 		return make([]byte, 4096)
@@ -7,7 +7,7 @@ import (
 )
 
 func BenchmarkGzipEncoder(b *testing.B) {
-	encoder := newEncoding(GzipEncoding, DefaultCompression)
+	encoder := newEncoding(GzipEncoding, DefaultCompression, nil)
 	data := bytes.NewReader(make([]byte, 1024*1024)) // 1 MB of data
 	b.ResetTimer()
 	b.ReportAllocs()
@@ -22,7 +22,7 @@ func BenchmarkGzipEncoder(b *testing.B) {
 }
 
 func BenchmarkDeflateEncoder(b *testing.B) {
-	encoder := newEncoding(DeflateEncoding, DefaultCompression)
+	encoder := newEncoding(DeflateEncoding, DefaultCompression, nil)
 	data := bytes.NewReader(make([]byte, 1024*1024)) // 1 MB of data
 	b.ResetTimer()
 	b.ReportAllocs()
@@ -37,7 +37,7 @@ func BenchmarkDeflateEncoder(b *testing.B) {
 }
 
 func BenchmarkBrotliEncoder(b *testing.B) {
-	encoder := newEncoding(BrotliEncoding, DefaultCompression)
+	encoder := newEncoding(BrotliEncoding, DefaultCompression, nil)
 	data := bytes.NewReader(make([]byte, 1024*1024)) // 1 MB of data
 	b.ResetTimer()
 	b.ReportAllocs()
@@ -52,7 +52,7 @@ func BenchmarkBrotliEncoder(b *testing.B) {
 }
 
 func BenchmarkGzipDecoder(b *testing.B) {
-	encoder := newEncoding(GzipEncoding, DefaultCompression)
+	encoder := newEncoding(GzipEncoding, DefaultCompression, nil)
 
 	// Prepare a valid JSON input
 	data := map[string]interface{}{
@@ -84,7 +84,7 @@ func BenchmarkGzipDecoder(b *testing.B) {
 }
 
 func BenchmarkFlateDecoder(b *testing.B) {
-	encoder := newEncoding(DeflateEncoding, DefaultCompression)
+	encoder := newEncoding(DeflateEncoding, DefaultCompression, nil)
 
 	// Prepare valid JSON input
 	data := map[string]interface{}{
@@ -116,7 +116,7 @@ func BenchmarkFlateDecoder(b *testing.B) {
 }
 
 func BenchmarkBrotliDecoder(b *testing.B) {
-	encoder := newEncoding(BrotliEncoding, DefaultCompression)
+	encoder := newEncoding(BrotliEncoding, DefaultCompression, nil)
 
 	// Prepare valid JSON input
 	data := map[string]interface{}{
@@ -8,9 +8,12 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
 	"testing"
@@ -132,12 +135,12 @@ func Test_Encode_ErrorInCopyZeroAlloc(t *testing.T) {
 }
 
 func Test_InvalidContentType(t *testing.T) {
-	enc := newEncoding("invalid", DefaultCompression)
+	enc := newEncoding("invalid", DefaultCompression, nil)
 	require.Nil(t, enc)
 }
 
 func TestGzipEncoder(t *testing.T) {
-	encoder := newEncoding(GzipEncoding, DefaultCompression)
+	encoder := newEncoding(GzipEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "gzip encoder should not be nil")
 
 	original := &mockData{Name: "John Doe", Age: 30}
@@ -162,7 +165,7 @@ func TestGzipEncoder(t *testing.T) {
 }
 
 func TestDeflateEncoder(t *testing.T) {
-	encoder := newEncoding(DeflateEncoding, DefaultCompression)
+	encoder := newEncoding(DeflateEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "deflate encoder should not be nil")
 
 	original := &mockData{Name: "Jane Doe", Age: 25}
@@ -187,7 +190,7 @@ func TestDeflateEncoder(t *testing.T) {
 }
 
 func TestBrotliEncoder(t *testing.T) {
-	encoder := newEncoding(BrotliEncoding, DefaultCompression)
+	encoder := newEncoding(BrotliEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "brotli encoder should not be nil")
 
 	original := &mockData{Name: "Jane Doe", Age: 25}
@@ -211,8 +214,172 @@ func TestBrotliEncoder(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestZstdEncoder(t *testing.T) {
+	encoder := newEncoding(ZstdEncoding, DefaultCompression, nil)
+	assert.NotNil(t, encoder, "zstd encoder should not be nil")
+
+	original := &mockData{Name: "John Doe", Age: 30}
+
+	originalJSON, err := json.Marshal(original)
+	assert.NoError(t, err, "marshalling original data should not produce an error")
+
+	readCloser := io.NopCloser(bytes.NewReader(originalJSON))
+
+	encodedData, err := encoder.Encode(readCloser)
+	assert.NoError(t, err, "encoding should not produce an error")
+	assert.NotNil(t, encodedData, "encoded data should not be nil")
+
+	var decoded mockData
+	err = encoder.Decode(encodedData.Bytes(), &decoded)
+	assert.NoError(t, err, "decoding should not produce an error")
+	assert.Equal(t, original, &decoded, "decoded data should match the original")
+
+	// the pooled decoder is reused
+	decoded = mockData{}
+	err = encoder.Decode(encodedData.Bytes(), &decoded)
+	assert.NoError(t, err, "decoding should not produce an error")
+	assert.Equal(t, original, &decoded, "decoded data should match the original")
+
+	var invalidType int
+	err = encoder.Decode(encodedData.Bytes(), &invalidType)
+	assert.Error(t, err)
+
+	err = encoder.Decode([]byte("invalid data"), &decoded)
+	assert.Error(t, err, "decoding invalid data should produce an error")
+}
+
+func TestZstdLevel(t *testing.T) {
+	assert.Equal(t, zstd.SpeedDefault, zstdLevel(DefaultCompression))
+	assert.Equal(t, zstd.SpeedFastest, zstdLevel(NoCompression))
+	assert.Equal(t, zstd.SpeedFastest, zstdLevel(BestSpeed))
+	assert.Equal(t, zstd.SpeedFastest, zstdLevel(StatelessCompression))
+	assert.Equal(t, zstd.SpeedDefault, zstdLevel(3))
+	assert.Equal(t, zstd.SpeedBetterCompression, zstdLevel(7))
+	assert.Equal(t, zstd.SpeedBestCompression, zstdLevel(BestCompression))
+}
+
+func TestUnsupportedContentEncodingFallback(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		encodings []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		mu.Unlock()
+
+		var body io.Reader = r.Body
+		switch r.Header.Get("Content-Encoding") {
+		case GzipEncoding.String():
+			gr, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			body = gr
+		case "":
+		default:
+			w.Header().Set("Accept-Encoding", "br;q=0, gzip, deflate")
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		require.JSONEq(t, `[{"id":1}]`, string(data))
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1}`))
+	}))
+	defer ts.Close()
+
+	sm := New(ts.URL, WithContentEncoding(ZstdEncoding, BestSpeed))
+	c := sm.(*meilisearch).client
+
+	_, err := sm.Index("movies").AddDocuments([]map[string]int{{"id": 1}})
+	require.NoError(t, err)
+	_, err = sm.Index("movies").UpdateDocuments(json.RawMessage(`[{"id":1}]`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"zstd", "gzip", "gzip"}, encodings)
+	require.Equal(t, GzipEncoding, c.requestEncoding.Load().encoding)
+
+	// without Accept-Encoding the request is sent without compression
+	encodings = nil
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		if r.Header.Get("Content-Encoding") != "" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1}`))
+	})
+	_, err = sm.Index("movies").AddDocumentsNdjson([]byte(`{"id":1}`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"gzip", ""}, encodings)
+	require.True(t, c.requestEncoding.Load().encoding.IsZero())
+}
+
+func TestRequestAndResponseEncodings(t *testing.T) {
+	var (
+		mu              sync.Mutex
+		contentEncoding []string
+		acceptEncoding  []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		contentEncoding = append(contentEncoding, r.Header.Get("Content-Encoding"))
+		acceptEncoding = append(acceptEncoding, r.Header.Get("Accept-Encoding"))
+		mu.Unlock()
+
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == ZstdEncoding.String() {
+			zr, err := zstd.NewReader(r.Body)
+			require.NoError(t, err)
+			defer zr.Close()
+			body = zr
+		}
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"q":"carol"`)
+
+		w.Header().Set("Content-Encoding", GzipEncoding.String())
+		gw := gzip.NewWriter(w)
+		_, _ = gw.Write([]byte(`{"hits":[{"id":1}],"query":"carol"}`))
+		_ = gw.Close()
+	}))
+	defer ts.Close()
+
+	index := New(ts.URL,
+		WithRequestEncoding(ZstdEncoding, BestSpeed),
+		WithAcceptEncodings(BrotliEncoding, GzipEncoding),
+	).Index("movies")
+	resp, err := index.Search("carol", &SearchRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "carol", resp.Query)
+	require.Len(t, resp.Hits, 1)
+
+	// the bodies smaller than the threshold are sent uncompressed
+	index = New(ts.URL,
+		WithRequestEncoding(ZstdEncoding, BestSpeed),
+		WithAcceptEncodings(BrotliEncoding, GzipEncoding),
+		WithCompressionThreshold(1024),
+	).Index("movies")
+	resp, err = index.Search("carol", &SearchRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "carol", resp.Query)
+
+	require.Equal(t, []string{"zstd", ""}, contentEncoding)
+	require.Equal(t, []string{"br, gzip", "br, gzip"}, acceptEncoding)
+
+	// a response compressed with an unknown algorithm is an error
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "compress")
+		_, _ = w.Write([]byte(`{}`))
+	})
+	_, err = index.Search("carol", &SearchRequest{})
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, ErrCodeResponseUnmarshalBody, apiErr.ErrCode)
+}
+
 func TestGzipEncoder_EmptyData(t *testing.T) {
-	encoder := newEncoding(GzipEncoding, DefaultCompression)
+	encoder := newEncoding(GzipEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "gzip encoder should not be nil")
 
 	original := &mockData{}
@@ -233,7 +400,7 @@ func TestGzipEncoder_EmptyData(t *testing.T) {
 }
 
 func TestDeflateEncoder_EmptyData(t *testing.T) {
-	encoder := newEncoding(DeflateEncoding, DefaultCompression)
+	encoder := newEncoding(DeflateEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "deflate encoder should not be nil")
 
 	original := &mockData{}
@@ -254,7 +421,7 @@ func TestDeflateEncoder_EmptyData(t *testing.T) {
 }
 
 func TestBrotliEncoder_EmptyData(t *testing.T) {
-	encoder := newEncoding(BrotliEncoding, DefaultCompression)
+	encoder := newEncoding(BrotliEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "brotli encoder should not be nil")
 
 	original := &mockData{}
@@ -275,7 +442,7 @@ func TestBrotliEncoder_EmptyData(t *testing.T) {
 }
 
 func TestGzipEncoder_InvalidData(t *testing.T) {
-	encoder := newEncoding(GzipEncoding, DefaultCompression)
+	encoder := newEncoding(GzipEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "gzip encoder should not be nil")
 
 	var decoded mockData
@@ -284,7 +451,7 @@ func TestGzipEncoder_InvalidData(t *testing.T) {
 }
 
 func TestDeflateEncoder_InvalidData(t *testing.T) {
-	encoder := newEncoding(DeflateEncoding, DefaultCompression)
+	encoder := newEncoding(DeflateEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "deflate encoder should not be nil")
 
 	var decoded mockData
@@ -293,7 +460,7 @@ func TestDeflateEncoder_InvalidData(t *testing.T) {
 }
 
 func TestBrotliEncoder_InvalidData(t *testing.T) {
-	encoder := newEncoding(BrotliEncoding, DefaultCompression)
+	encoder := newEncoding(BrotliEncoding, DefaultCompression, nil)
 	assert.NotNil(t, encoder, "brotli encoder should not be nil")
 
 	var decoded mockData
@@ -1,7 +1,6 @@
 package meilisearch
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -61,13 +60,6 @@ func (e ErrCode) rawMessage() string {
 	}
 }
 
-type meilisearchApiError struct {
-	Message string `json:"message"`
-	Code    string `json:"code"`
-	Type    string `json:"type"`
-	Link    string `json:"link"`
-}
-
 // Error is the internal error structure that all exposed method use.
 // So ALL errors returned by this library can be cast to this struct (as a pointer)
 type Error struct {
@@ -88,7 +80,7 @@ type Error struct {
 
 	// Error info from meilisearch api
 	// Message is the raw request into string ('empty meilisearch message' if not present)
-	MeilisearchApiError meilisearchApiError
+	MeilisearchApiError APIError
 
 	// StatusCode of the request
 	StatusCode int
@@ -105,7 +97,11 @@ type Error struct {
 	// an error.
 	ErrCode ErrCode
 
-	encoder
+	// bodyCapture controls how the bodies are captured, see WithErrorBodyCapture
+	bodyCapture *BodyCapture
+
+	// jsonCodec unmarshals the error bodies, see WithJSONCodec
+	jsonCodec JSONCodec
 }
 
 // Error return a well human formatted message.
@@ -130,6 +126,17 @@ func (e *Error) Error() string {
 	return message
 }
 
+// Is reports whether the meilisearch error has the code target, eg. errors.Is(err, APIErrorCodeIndexNotFound)
+func (e *Error) Is(target error) bool {
+	code, ok := target.(APIErrorCode)
+	return ok && e.MeilisearchApiError.Code == code
+}
+
+// Unwrap returns the OriginError
+func (e *Error) Unwrap() error {
+	return e.OriginError
+}
+
 // WithErrCode add an error code to an error
 func (e *Error) WithErrCode(err ErrCode, errs ...error) *Error {
 	if errs != nil {
@@ -143,21 +150,10 @@ func (e *Error) WithErrCode(err ErrCode, errs ...error) *Error {
 
 // ErrorBody add a body to an error
 func (e *Error) ErrorBody(body []byte) {
-	msg := meilisearchApiError{}
-
-	if e.encoder != nil {
-		err := e.encoder.Decode(body, &msg)
-		if err == nil {
-			e.MeilisearchApiError.Message = msg.Message
-			e.MeilisearchApiError.Code = msg.Code
-			e.MeilisearchApiError.Type = msg.Type
-			e.MeilisearchApiError.Link = msg.Link
-		}
-		return
-	}
+	msg := APIError{}
 
-	e.ResponseToString = string(body)
-	err := json.Unmarshal(body, &msg)
+	e.ResponseToString = e.bodyCapture.capture(e.Endpoint, body)
+	err := codecOrDefault(e.jsonCodec).Unmarshal(body, &msg)
 	if err == nil {
 		e.MeilisearchApiError.Message = msg.Message
 		e.MeilisearchApiError.Code = msg.Code
@@ -186,4 +182,25 @@ var (
 	ErrNoSearchRequest               = errors.New("no search request provided")
 	ErrNoFacetSearchRequest          = errors.New("no search facet request provided")
 	ErrConnectingFailed              = errors.New("meilisearch is not connected")
+	ErrNoRankingScoreDetails         = errors.New("hit has no ranking score details, set ShowRankingScoreDetails in the search request")
+	ErrNoGeoDistance                 = errors.New("hit has no geo distance, filter or sort the search on a geo point")
+	ErrNoGeoPoint                    = errors.New("hit has no _geo field")
+	ErrInvalidGeoPoint               = errors.New("invalid geo point")
+	ErrInvalidSchema                 = errors.New("invalid index schema")
+	ErrInvalidPrimaryKey             = errors.New("invalid document primary key")
+	ErrEmptyPatch                    = errors.New("document patch has no operation")
+	ErrInvalidPatch                  = errors.New("invalid document patch")
+	ErrPatchNeedsFunction            = errors.New("document patch reads current values and must be sent as a function")
+	ErrNoDocumentIdentifiers         = errors.New("no document identifiers provided")
+	ErrPrimaryKeyNotFilterable       = errors.New("primary key is not a filterable attribute")
+	ErrInvalidEditFunction           = errors.New("invalid edit function")
+	ErrExperimentalFeatureDisabled   = errors.New("experimental feature is not enabled")
+	ErrEditDryRunFailed              = errors.New("edit function dry run failed")
+	ErrEditDryRunCleanup             = errors.New("edit function dry run index not deleted")
+	ErrInvalidMetrics                = errors.New("invalid prometheus metrics")
+	ErrInvalidIndexPattern           = errors.New("invalid index uid pattern")
+	ErrInvalidAlias                  = errors.New("invalid index alias")
+	ErrTaskNotSucceeded              = errors.New("task did not succeed")
+	ErrInvalidRetentionRule          = errors.New("invalid index retention rule")
+	ErrInvalidDuration               = errors.New("invalid ISO-8601 duration")
 )
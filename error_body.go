@@ -0,0 +1,62 @@
+package meilisearch
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const omittedBody = "omitted body"
+
+// BodyCapture controls how the bodies are captured in the RequestToString and ResponseToString of an Error,
+// the zero value captures them entirely.
+type BodyCapture struct {
+	// Disabled omits the bodies
+	Disabled bool
+	// Redact is applied to the bodies before they are truncated, eg. RedactSecrets
+	Redact func(body []byte) []byte
+	// MaxBytes truncates the bodies to their first MaxBytes bytes, 0 means no limit
+	MaxBytes int
+}
+
+var (
+	// secretFields matches the JSON string values of the fields holding secrets: the api keys of the
+	// embedders and the Authorization header of the rest embedders
+	secretFields = regexp.MustCompile(`(?i)("(?:apiKey|api_key|authorization|masterKey)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// keyField matches the api keys in the bodies of the /keys endpoints, `key` is a common document
+	// field elsewhere
+	keyField = regexp.MustCompile(`("key"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// RedactSecrets replaces the values of the known secret fields of a JSON body, eg. `apiKey` and
+// `Authorization`, by "[REDACTED]". It does not need the body to be valid JSON. When it is set in a
+// BodyCapture, the api keys of the bodies of the /keys endpoints are redacted too.
+func RedactSecrets(body []byte) []byte {
+	return secretFields.ReplaceAll(body, []byte(`$1"[REDACTED]"`))
+}
+
+// isKeysEndpoint reports whether endpoint is one of the /keys endpoints
+func isKeysEndpoint(endpoint string) bool {
+	return endpoint == "/keys" || strings.HasPrefix(endpoint, "/keys/") || strings.HasPrefix(endpoint, "/keys?")
+}
+
+// capture returns the body of a request or a response of endpoint as it is captured
+func (b *BodyCapture) capture(endpoint string, body []byte) string {
+	if b == nil {
+		return string(body)
+	}
+	if b.Disabled {
+		return omittedBody
+	}
+	if b.Redact != nil {
+		body = b.Redact(body)
+		if isKeysEndpoint(endpoint) {
+			body = keyField.ReplaceAll(body, []byte(`$1"[REDACTED]"`))
+		}
+	}
+	if b.MaxBytes > 0 && len(body) > b.MaxBytes {
+		return string(body[:b.MaxBytes]) + "... (" + strconv.Itoa(len(body)-b.MaxBytes) + " bytes truncated)"
+	}
+	return string(body)
+}
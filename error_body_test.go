@@ -0,0 +1,73 @@
+package meilisearch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	body := `{"embedders":{"default":{"source":"openAi","apiKey":"sk-\"secret\"","model":"m"},` +
+		`"rest":{"source":"rest","headers":{"Authorization" : "Bearer token"}}},"masterKey":"secret"}`
+	require.Equal(t, `{"embedders":{"default":{"source":"openAi","apiKey":"[REDACTED]","model":"m"},`+
+		`"rest":{"source":"rest","headers":{"Authorization" : "[REDACTED]"}}},"masterKey":"[REDACTED]"}`, string(RedactSecrets([]byte(body))))
+
+	// key is a common document field
+	require.Equal(t, `{"key":"value"}`, string(RedactSecrets([]byte(`{"key":"value"}`))))
+
+	// truncated bodies are redacted too
+	require.Equal(t, `{"apiKey":"[REDACTED]","title":"tr`, string(RedactSecrets([]byte(`{"apiKey":"sk-secret","title":"tr`))))
+}
+
+func TestBodyCapture(t *testing.T) {
+	body := []byte(`{"apiKey":"sk-secret","title":"The Lord of the Rings"}`)
+
+	var capture *BodyCapture
+	require.Equal(t, string(body), capture.capture("/indexes", body))
+	require.Equal(t, omittedBody, (&BodyCapture{Disabled: true}).capture("/indexes", body))
+	require.Equal(t, `{"apiKey":"sk-secret",... (32 bytes truncated)`, (&BodyCapture{MaxBytes: 22}).capture("/indexes", body))
+	require.Equal(t, `{"apiKey":"[REDACTED]","title":"The Lord of the Rings"}`, (&BodyCapture{Redact: RedactSecrets}).capture("/indexes", body))
+	require.Equal(t, `{"apiKey":"[REDACTED]",... (32 bytes truncated)`, (&BodyCapture{Redact: RedactSecrets, MaxBytes: 23}).capture("/indexes", body))
+
+	// the api keys are redacted from the bodies of the /keys endpoints only
+	keys := []byte(`{"results":[{"key":"d0552b41536279a0","uid":"42"}]}`)
+	require.Equal(t, `{"results":[{"key":"[REDACTED]","uid":"42"}]}`, (&BodyCapture{Redact: RedactSecrets}).capture("/keys", keys))
+	require.Equal(t, `{"key":"[REDACTED]"}`, (&BodyCapture{Redact: RedactSecrets}).capture("/keys/42", []byte(`{"key":"d0552b41"}`)))
+	require.Equal(t, string(keys), (&BodyCapture{Redact: RedactSecrets}).capture("/indexes/keys/documents", keys))
+	require.Equal(t, string(keys), (&BodyCapture{}).capture("/keys", keys))
+}
+
+func TestWithErrorBodyCapture(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/keys/default":
+			_, _ = w.Write([]byte(`{"key":"d0552b41536279a0ad88bd595327b96f","uid":42}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>` + strings.Repeat("a", 100) + `</html>`))
+		}
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL, DisableRetries(), WithErrorBodyCapture(BodyCapture{Redact: RedactSecrets, MaxBytes: 16}))
+
+	_, err := client.GetKey("default")
+	require.Error(t, err)
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, ErrCodeResponseUnmarshalBody, apiErr.ErrCode)
+	require.Equal(t, `{"key":"[REDACTE... (13 bytes truncated)`, apiErr.ResponseToString)
+	require.NotContains(t, err.Error(), "d0552b41")
+
+	_, err = client.GetIndex("movies")
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, MeilisearchApiErrorWithoutMessage, apiErr.ErrCode)
+	require.Equal(t, `<html>aaaaaaaaaa... (97 bytes truncated)`, apiErr.ResponseToString)
+
+	_, err = New(ts.URL, DisableRetries(), WithErrorBodyCapture(BodyCapture{Disabled: true})).GetKey("default")
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, omittedBody, apiErr.ResponseToString)
+}
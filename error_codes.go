@@ -0,0 +1,233 @@
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// APIErrorCode is the code of an error returned by meilisearch. The codes are errors themselves so
+// errors.Is(err, APIErrorCodeIndexNotFound) reports whether err, an *Error or a *TaskError, has the code.
+//
+// Documentation: https://www.meilisearch.com/docs/reference/errors/error_codes
+type APIErrorCode string
+
+// Error implements the error interface
+func (c APIErrorCode) Error() string {
+	return string(c)
+}
+
+// APIErrorType is the type of an error returned by meilisearch
+type APIErrorType string
+
+const (
+	// APIErrorTypeInvalidRequest the request is invalid, eg. a wrong parameter or a missing index
+	APIErrorTypeInvalidRequest APIErrorType = "invalid_request"
+	// APIErrorTypeInternal meilisearch failed because of an unexpected error
+	APIErrorTypeInternal APIErrorType = "internal"
+	// APIErrorTypeAuth the API key is missing, invalid or not allowed to perform the request
+	APIErrorTypeAuth APIErrorType = "auth"
+	// APIErrorTypeSystem meilisearch failed because of the system it runs on, eg. no space left
+	APIErrorTypeSystem APIErrorType = "system"
+)
+
+// Meilisearch error codes
+const (
+	APIErrorCodeAPIKeyAlreadyExists                    APIErrorCode = "api_key_already_exists"
+	APIErrorCodeAPIKeyNotFound                         APIErrorCode = "api_key_not_found"
+	APIErrorCodeBadRequest                             APIErrorCode = "bad_request"
+	APIErrorCodeBatchNotFound                          APIErrorCode = "batch_not_found"
+	APIErrorCodeDatabaseSizeLimitReached               APIErrorCode = "database_size_limit_reached"
+	APIErrorCodeDocumentFieldsLimitReached             APIErrorCode = "document_fields_limit_reached"
+	APIErrorCodeDocumentNotFound                       APIErrorCode = "document_not_found"
+	APIErrorCodeDumpProcessFailed                      APIErrorCode = "dump_process_failed"
+	APIErrorCodeDuplicateIndexFound                    APIErrorCode = "duplicate_index_found"
+	APIErrorCodeFeatureNotEnabled                      APIErrorCode = "feature_not_enabled"
+	APIErrorCodeImmutableAPIKeyActions                 APIErrorCode = "immutable_api_key_actions"
+	APIErrorCodeImmutableAPIKeyExpiresAt               APIErrorCode = "immutable_api_key_expires_at"
+	APIErrorCodeImmutableAPIKeyIndexes                 APIErrorCode = "immutable_api_key_indexes"
+	APIErrorCodeImmutableAPIKeyKey                     APIErrorCode = "immutable_api_key_key"
+	APIErrorCodeImmutableAPIKeyUID                     APIErrorCode = "immutable_api_key_uid"
+	APIErrorCodeImmutableIndexUID                      APIErrorCode = "immutable_index_uid"
+	APIErrorCodeIndexAlreadyExists                     APIErrorCode = "index_already_exists"
+	APIErrorCodeIndexCreationFailed                    APIErrorCode = "index_creation_failed"
+	APIErrorCodeIndexNotFound                          APIErrorCode = "index_not_found"
+	APIErrorCodeIndexPrimaryKeyAlreadyExists           APIErrorCode = "index_primary_key_already_exists"
+	APIErrorCodeIndexPrimaryKeyMultipleCandidatesFound APIErrorCode = "index_primary_key_multiple_candidates_found"
+	APIErrorCodeIndexPrimaryKeyNoCandidateFound        APIErrorCode = "index_primary_key_no_candidate_found"
+	APIErrorCodeInternal                               APIErrorCode = "internal"
+	APIErrorCodeInvalidAPIKey                          APIErrorCode = "invalid_api_key"
+	APIErrorCodeInvalidAPIKeyActions                   APIErrorCode = "invalid_api_key_actions"
+	APIErrorCodeInvalidAPIKeyDescription               APIErrorCode = "invalid_api_key_description"
+	APIErrorCodeInvalidAPIKeyExpiresAt                 APIErrorCode = "invalid_api_key_expires_at"
+	APIErrorCodeInvalidAPIKeyIndexes                   APIErrorCode = "invalid_api_key_indexes"
+	APIErrorCodeInvalidAPIKeyName                      APIErrorCode = "invalid_api_key_name"
+	APIErrorCodeInvalidAPIKeyUID                       APIErrorCode = "invalid_api_key_uid"
+	APIErrorCodeInvalidContentType                     APIErrorCode = "invalid_content_type"
+	APIErrorCodeInvalidDocumentCsvDelimiter            APIErrorCode = "invalid_document_csv_delimiter"
+	APIErrorCodeInvalidDocumentFields                  APIErrorCode = "invalid_document_fields"
+	APIErrorCodeInvalidDocumentFilter                  APIErrorCode = "invalid_document_filter"
+	APIErrorCodeInvalidDocumentGeoField                APIErrorCode = "invalid_document_geo_field"
+	APIErrorCodeInvalidDocumentID                      APIErrorCode = "invalid_document_id"
+	APIErrorCodeInvalidDocumentLimit                   APIErrorCode = "invalid_document_limit"
+	APIErrorCodeInvalidDocumentOffset                  APIErrorCode = "invalid_document_offset"
+	APIErrorCodeInvalidEmbedder                        APIErrorCode = "invalid_embedder"
+	APIErrorCodeInvalidFacetSearchFacetName            APIErrorCode = "invalid_facet_search_facet_name"
+	APIErrorCodeInvalidFacetSearchFacetQuery           APIErrorCode = "invalid_facet_search_facet_query"
+	APIErrorCodeInvalidIndexLimit                      APIErrorCode = "invalid_index_limit"
+	APIErrorCodeInvalidIndexOffset                     APIErrorCode = "invalid_index_offset"
+	APIErrorCodeInvalidIndexPrimaryKey                 APIErrorCode = "invalid_index_primary_key"
+	APIErrorCodeInvalidIndexUID                        APIErrorCode = "invalid_index_uid"
+	APIErrorCodeInvalidSearchAttributesToCrop          APIErrorCode = "invalid_search_attributes_to_crop"
+	APIErrorCodeInvalidSearchAttributesToHighlight     APIErrorCode = "invalid_search_attributes_to_highlight"
+	APIErrorCodeInvalidSearchAttributesToRetrieve      APIErrorCode = "invalid_search_attributes_to_retrieve"
+	APIErrorCodeInvalidSearchAttributesToSearchOn      APIErrorCode = "invalid_search_attributes_to_search_on"
+	APIErrorCodeInvalidSearchEmbedder                  APIErrorCode = "invalid_search_embedder"
+	APIErrorCodeInvalidSearchFacets                    APIErrorCode = "invalid_search_facets"
+	APIErrorCodeInvalidSearchFilter                    APIErrorCode = "invalid_search_filter"
+	APIErrorCodeInvalidSearchHitsPerPage               APIErrorCode = "invalid_search_hits_per_page"
+	APIErrorCodeInvalidSearchHybridQuery               APIErrorCode = "invalid_search_hybrid_query"
+	APIErrorCodeInvalidSearchLimit                     APIErrorCode = "invalid_search_limit"
+	APIErrorCodeInvalidSearchLocales                   APIErrorCode = "invalid_search_locales"
+	APIErrorCodeInvalidSearchMatchingStrategy          APIErrorCode = "invalid_search_matching_strategy"
+	APIErrorCodeInvalidSearchOffset                    APIErrorCode = "invalid_search_offset"
+	APIErrorCodeInvalidSearchPage                      APIErrorCode = "invalid_search_page"
+	APIErrorCodeInvalidSearchQ                         APIErrorCode = "invalid_search_q"
+	APIErrorCodeInvalidSearchRankingScoreThreshold     APIErrorCode = "invalid_search_ranking_score_threshold"
+	APIErrorCodeInvalidSearchSort                      APIErrorCode = "invalid_search_sort"
+	APIErrorCodeInvalidSearchVector                    APIErrorCode = "invalid_search_vector"
+	APIErrorCodeInvalidSettingsDisplayedAttributes     APIErrorCode = "invalid_settings_displayed_attributes"
+	APIErrorCodeInvalidSettingsDistinctAttribute       APIErrorCode = "invalid_settings_distinct_attribute"
+	APIErrorCodeInvalidSettingsEmbedders               APIErrorCode = "invalid_settings_embedders"
+	APIErrorCodeInvalidSettingsFaceting                APIErrorCode = "invalid_settings_faceting"
+	APIErrorCodeInvalidSettingsFilterableAttributes    APIErrorCode = "invalid_settings_filterable_attributes"
+	APIErrorCodeInvalidSettingsPagination              APIErrorCode = "invalid_settings_pagination"
+	APIErrorCodeInvalidSettingsRankingRules            APIErrorCode = "invalid_settings_ranking_rules"
+	APIErrorCodeInvalidSettingsSearchableAttributes    APIErrorCode = "invalid_settings_searchable_attributes"
+	APIErrorCodeInvalidSettingsSortableAttributes      APIErrorCode = "invalid_settings_sortable_attributes"
+	APIErrorCodeInvalidSettingsStopWords               APIErrorCode = "invalid_settings_stop_words"
+	APIErrorCodeInvalidSettingsSynonyms                APIErrorCode = "invalid_settings_synonyms"
+	APIErrorCodeInvalidSettingsTypoTolerance           APIErrorCode = "invalid_settings_typo_tolerance"
+	APIErrorCodeInvalidSimilarID                       APIErrorCode = "invalid_similar_id"
+	APIErrorCodeInvalidState                           APIErrorCode = "invalid_state"
+	APIErrorCodeInvalidSwapDuplicateIndexFound         APIErrorCode = "invalid_swap_duplicate_index_found"
+	APIErrorCodeInvalidSwapIndexes                     APIErrorCode = "invalid_swap_indexes"
+	APIErrorCodeInvalidTaskAfterEnqueuedAt             APIErrorCode = "invalid_task_after_enqueued_at"
+	APIErrorCodeInvalidTaskAfterFinishedAt             APIErrorCode = "invalid_task_after_finished_at"
+	APIErrorCodeInvalidTaskAfterStartedAt              APIErrorCode = "invalid_task_after_started_at"
+	APIErrorCodeInvalidTaskBeforeEnqueuedAt            APIErrorCode = "invalid_task_before_enqueued_at"
+	APIErrorCodeInvalidTaskBeforeFinishedAt            APIErrorCode = "invalid_task_before_finished_at"
+	APIErrorCodeInvalidTaskBeforeStartedAt             APIErrorCode = "invalid_task_before_started_at"
+	APIErrorCodeInvalidTaskCanceledBy                  APIErrorCode = "invalid_task_canceled_by"
+	APIErrorCodeInvalidTaskIndexUIDs                   APIErrorCode = "invalid_task_index_uids"
+	APIErrorCodeInvalidTaskLimit                       APIErrorCode = "invalid_task_limit"
+	APIErrorCodeInvalidTaskStatuses                    APIErrorCode = "invalid_task_statuses"
+	APIErrorCodeInvalidTaskTypes                       APIErrorCode = "invalid_task_types"
+	APIErrorCodeInvalidTaskUIDs                        APIErrorCode = "invalid_task_uids"
+	APIErrorCodeIOError                                APIErrorCode = "io_error"
+	APIErrorCodeMalformedPayload                       APIErrorCode = "malformed_payload"
+	APIErrorCodeMaxFieldsLimitExceeded                 APIErrorCode = "max_fields_limit_exceeded"
+	APIErrorCodeMissingAPIKeyActions                   APIErrorCode = "missing_api_key_actions"
+	APIErrorCodeMissingAPIKeyExpiresAt                 APIErrorCode = "missing_api_key_expires_at"
+	APIErrorCodeMissingAPIKeyIndexes                   APIErrorCode = "missing_api_key_indexes"
+	APIErrorCodeMissingAuthorizationHeader             APIErrorCode = "missing_authorization_header"
+	APIErrorCodeMissingContentType                     APIErrorCode = "missing_content_type"
+	APIErrorCodeMissingDocumentFilter                  APIErrorCode = "missing_document_filter"
+	APIErrorCodeMissingDocumentID                      APIErrorCode = "missing_document_id"
+	APIErrorCodeMissingFacetSearchFacetName            APIErrorCode = "missing_facet_search_facet_name"
+	APIErrorCodeMissingIndexUID                        APIErrorCode = "missing_index_uid"
+	APIErrorCodeMissingMasterKey                       APIErrorCode = "missing_master_key"
+	APIErrorCodeMissingPayload                         APIErrorCode = "missing_payload"
+	APIErrorCodeMissingSwapIndexes                     APIErrorCode = "missing_swap_indexes"
+	APIErrorCodeMissingTaskFilters                     APIErrorCode = "missing_task_filters"
+	APIErrorCodeNoSpaceLeftOnDevice                    APIErrorCode = "no_space_left_on_device"
+	APIErrorCodeNotFound                               APIErrorCode = "not_found"
+	APIErrorCodePayloadTooLarge                        APIErrorCode = "payload_too_large"
+	APIErrorCodeTaskNotFound                           APIErrorCode = "task_not_found"
+	APIErrorCodeTooManyOpenFiles                       APIErrorCode = "too_many_open_files"
+	APIErrorCodeTooManySearchRequests                  APIErrorCode = "too_many_search_requests"
+	APIErrorCodeUnretrievableDocument                  APIErrorCode = "unretrievable_document"
+	APIErrorCodeVectorEmbeddingError                   APIErrorCode = "vector_embedding_error"
+)
+
+// APIError is the error returned by meilisearch in the body of a failed request
+type APIError struct {
+	Message string       `json:"message"`
+	Code    APIErrorCode `json:"code"`
+	Type    APIErrorType `json:"type"`
+	Link    string       `json:"link"`
+}
+
+// retryableCodes are the codes of the errors which may not happen again later
+var retryableCodes = map[APIErrorCode]bool{
+	APIErrorCodeTooManySearchRequests: true,
+	APIErrorCodeTooManyOpenFiles:      true,
+	APIErrorCodeIOError:               true,
+}
+
+// IsRetryable reports whether the request or the task which failed with err may succeed if sent
+// again later: the network errors, the timeouts and the errors of an overloaded instance.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var taskErr *TaskError
+	if errors.As(err, &taskErr) {
+		return retryableCodes[taskErr.Code]
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.ErrCode {
+	case MeilisearchTimeoutError, MeilisearchCommunicationError, MeilisearchMaxRetriesExceeded:
+		return true
+	}
+	if retryableCodes[apiErr.MeilisearchApiError.Code] {
+		return true
+	}
+	switch apiErr.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// IsAuthError reports whether err is caused by a missing or invalid API key, or by a key not
+// allowed to perform the request
+func IsAuthError(err error) bool {
+	var taskErr *TaskError
+	if errors.As(err, &taskErr) {
+		return taskErr.Type == APIErrorTypeAuth
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.MeilisearchApiError.Type == APIErrorTypeAuth ||
+		apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden
+}
+
+// IsUserInputError reports whether err is caused by the request itself, eg. an invalid filter, a
+// missing index or a document without primary key, sending it again will fail the same way
+func IsUserInputError(err error) bool {
+	var taskErr *TaskError
+	if errors.As(err, &taskErr) {
+		return taskErr.Type == APIErrorTypeInvalidRequest
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.ErrCode == ErrCodeMarshalRequest || apiErr.MeilisearchApiError.Type == APIErrorTypeInvalidRequest {
+		return true
+	}
+	// an error without meilisearch body, eg. from a proxy
+	return apiErr.MeilisearchApiError.Type == "" && apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 &&
+		!IsAuthError(err) && !IsRetryable(err)
+}
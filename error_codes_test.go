@@ -0,0 +1,85 @@
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestError_Is(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Index ` + "`movies`" + ` not found.","code":"index_not_found","type":"invalid_request","link":"https://docs.meilisearch.com/errors#index_not_found"}`))
+	}))
+	defer ts.Close()
+
+	_, err := New(ts.URL).GetIndex("movies")
+	require.ErrorIs(t, err, APIErrorCodeIndexNotFound)
+	require.NotErrorIs(t, err, APIErrorCodeDocumentNotFound)
+	require.ErrorIs(t, fmt.Errorf("wrapped: %w", err), APIErrorCodeIndexNotFound)
+
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, APIErrorCodeIndexNotFound, apiErr.MeilisearchApiError.Code)
+	require.Equal(t, APIErrorTypeInvalidRequest, apiErr.MeilisearchApiError.Type)
+	require.True(t, IsUserInputError(err))
+	require.False(t, IsRetryable(err))
+	require.False(t, IsAuthError(err))
+
+	task := &Task{Status: TaskStatusFailed, Error: TaskError{Code: APIErrorCodeInvalidDocumentID, Type: APIErrorTypeInvalidRequest}}
+	require.ErrorIs(t, task.Err(), APIErrorCodeInvalidDocumentID)
+	require.True(t, IsUserInputError(task.Err()))
+}
+
+func TestError_Unwrap(t *testing.T) {
+	origin := errors.New("connection refused")
+	err := (&Error{}).WithErrCode(MeilisearchCommunicationError, origin)
+	require.ErrorIs(t, err, origin)
+	require.True(t, IsRetryable(err))
+
+	err = (&Error{}).WithErrCode(MeilisearchTimeoutError, context.DeadlineExceeded)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, IsRetryable(err))
+
+	err = (&Error{}).WithErrCode(MeilisearchTimeoutError, context.Canceled)
+	require.False(t, IsRetryable(err))
+}
+
+func TestErrorClassification(t *testing.T) {
+	apiError := func(status int, code APIErrorCode, errType APIErrorType) error {
+		return &Error{StatusCode: status, ErrCode: MeilisearchApiError, MeilisearchApiError: APIError{Code: code, Type: errType}}
+	}
+
+	tests := []struct {
+		name                     string
+		err                      error
+		retryable, auth, invalid bool
+	}{
+		{"nil", nil, false, false, false},
+		{"other error", errors.New("boom"), false, false, false},
+		{"invalid api key", apiError(http.StatusForbidden, APIErrorCodeInvalidAPIKey, APIErrorTypeAuth), false, true, false},
+		{"missing authorization", apiError(http.StatusUnauthorized, APIErrorCodeMissingAuthorizationHeader, APIErrorTypeAuth), false, true, false},
+		{"invalid filter", apiError(http.StatusBadRequest, APIErrorCodeInvalidSearchFilter, APIErrorTypeInvalidRequest), false, false, true},
+		{"too many search requests", apiError(http.StatusServiceUnavailable, APIErrorCodeTooManySearchRequests, APIErrorTypeSystem), true, false, false},
+		{"internal", apiError(http.StatusInternalServerError, APIErrorCodeInternal, APIErrorTypeInternal), false, false, false},
+		{"proxy bad gateway", apiError(http.StatusBadGateway, "", ""), true, false, false},
+		{"proxy too large", apiError(http.StatusRequestEntityTooLarge, "", ""), false, false, true},
+		{"proxy forbidden", apiError(http.StatusForbidden, "", ""), false, true, false},
+		{"marshal request", (&Error{}).WithErrCode(ErrCodeMarshalRequest, errors.New("json")), false, false, true},
+		{"max retries", (&Error{}).WithErrCode(MeilisearchMaxRetriesExceeded, nil), true, false, false},
+		{"task io error", &TaskError{Code: APIErrorCodeIOError, Type: APIErrorTypeSystem}, true, false, false},
+		{"task auth", &TaskError{Code: APIErrorCodeInvalidAPIKey, Type: APIErrorTypeAuth}, false, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.retryable, IsRetryable(tt.err), "IsRetryable")
+			require.Equal(t, tt.auth, IsAuthError(tt.err), "IsAuthError")
+			require.Equal(t, tt.invalid, IsUserInputError(tt.err), "IsUserInputError")
+		})
+	}
+}
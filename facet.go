@@ -0,0 +1,56 @@
+package meilisearch
+
+import "sort"
+
+// Facets returns the names of the facets present in the distribution, sorted alphabetically.
+func (f FacetDistribution) Facets() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Sorted returns the values of the given facet as a list ready to be rendered.
+//
+// SortFacetTypeCount orders values by descending count, SortFacetTypeAlpha (the default)
+// orders them alphabetically. Ties are always broken alphabetically so the output is stable.
+func (f FacetDistribution) Sorted(facet string, by SortFacetType) []FacetHit {
+	values, ok := f[facet]
+	if !ok {
+		return nil
+	}
+
+	hits := make([]FacetHit, 0, len(values))
+	for value, count := range values {
+		hits = append(hits, FacetHit{Value: value, Count: count})
+	}
+	SortFacetHits(hits, by)
+	return hits
+}
+
+// Get returns the stats of the given facet and whether it was present in the response.
+func (f FacetStats) Get(facet string) (FacetStat, bool) {
+	stat, ok := f[facet]
+	return stat, ok
+}
+
+// Sorted returns a copy of the facet hits ordered the same way as FacetDistribution.Sorted.
+func (r *FacetSearchResponse) Sorted(by SortFacetType) []FacetHit {
+	hits := make([]FacetHit, len(r.FacetHits))
+	copy(hits, r.FacetHits)
+	SortFacetHits(hits, by)
+	return hits
+}
+
+// SortFacetHits sorts hits in place, by descending count for SortFacetTypeCount or
+// alphabetically otherwise.
+func SortFacetHits(hits []FacetHit, by SortFacetType) {
+	sort.SliceStable(hits, func(a, b int) bool {
+		if by == SortFacetTypeCount && hits[a].Count != hits[b].Count {
+			return hits[a].Count > hits[b].Count
+		}
+		return hits[a].Value < hits[b].Value
+	})
+}
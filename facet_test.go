@@ -0,0 +1,78 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFacetDistribution_Sorted(t *testing.T) {
+	dist := FacetDistribution{
+		"tag": {
+			"Novel":        5,
+			"Epic fantasy": 3,
+			"Tragedy":      3,
+			"Tale":         2,
+		},
+		"year": {
+			"1865": 2,
+		},
+	}
+
+	require.Equal(t, []string{"tag", "year"}, dist.Facets())
+
+	require.Equal(t, []FacetHit{
+		{Value: "Novel", Count: 5},
+		{Value: "Epic fantasy", Count: 3},
+		{Value: "Tragedy", Count: 3},
+		{Value: "Tale", Count: 2},
+	}, dist.Sorted("tag", SortFacetTypeCount))
+
+	require.Equal(t, []FacetHit{
+		{Value: "Epic fantasy", Count: 3},
+		{Value: "Novel", Count: 5},
+		{Value: "Tale", Count: 2},
+		{Value: "Tragedy", Count: 3},
+	}, dist.Sorted("tag", SortFacetTypeAlpha))
+
+	require.Nil(t, dist.Sorted("unknown", SortFacetTypeAlpha))
+}
+
+func TestFacetSearchResponse_Sorted(t *testing.T) {
+	resp := &FacetSearchResponse{
+		FacetHits: []FacetHit{
+			{Value: "b", Count: 1},
+			{Value: "a", Count: 1},
+			{Value: "c", Count: 4},
+		},
+	}
+
+	require.Equal(t, []FacetHit{
+		{Value: "c", Count: 4},
+		{Value: "a", Count: 1},
+		{Value: "b", Count: 1},
+	}, resp.Sorted(SortFacetTypeCount))
+
+	// the response itself is left untouched
+	require.Equal(t, "b", resp.FacetHits[0].Value)
+}
+
+func TestSearchResponse_UnmarshalFacets(t *testing.T) {
+	data := []byte(`{
+		"hits": [],
+		"query": "",
+		"processingTimeMs": 1,
+		"facetDistribution": {"book_id": {"4": 1, "456": 1}},
+		"facetStats": {"book_id": {"min": 4, "max": 456}}
+	}`)
+
+	var resp SearchResponse
+	require.NoError(t, json.Unmarshal(data, &resp))
+
+	require.Equal(t, FacetDistribution{"book_id": {"4": 1, "456": 1}}, resp.FacetDistribution)
+
+	stat, ok := resp.FacetStats.Get("book_id")
+	require.True(t, ok)
+	require.Equal(t, FacetStat{Min: 4, Max: 456}, stat)
+}
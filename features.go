@@ -2,9 +2,146 @@ package meilisearch
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"regexp"
 )
 
+// ExperimentalFeature is the name of an experimental feature flag of /experimental-features
+type ExperimentalFeature string
+
+const (
+	ExperimentalFeatureVectorStore             ExperimentalFeature = "vectorStore"
+	ExperimentalFeatureLogsRoute               ExperimentalFeature = "logsRoute"
+	ExperimentalFeatureMetrics                 ExperimentalFeature = "metrics"
+	ExperimentalFeatureEditDocumentsByFunction ExperimentalFeature = "editDocumentsByFunction"
+	ExperimentalFeatureContainsFilter          ExperimentalFeature = "containsFilter"
+)
+
+// ExperimentalFeaturesBase is the request body to update the experimental features.
+// Features holds the flags unknown to this version of the SDK, see ExperimentalFeatures.Set.
+type ExperimentalFeaturesBase struct {
+	VectorStore             *bool           `json:"vectorStore,omitempty"`
+	LogsRoute               *bool           `json:"logsRoute,omitempty"`
+	Metrics                 *bool           `json:"metrics,omitempty"`
+	EditDocumentsByFunction *bool           `json:"editDocumentsByFunction,omitempty"`
+	ContainsFilter          *bool           `json:"containsFilter,omitempty"`
+	Features                map[string]bool `json:"-"`
+}
+
+// MarshalJSON supports json.Marshaler interface, the unknown flags are sent next to the known ones
+func (b ExperimentalFeaturesBase) MarshalJSON() ([]byte, error) {
+	m := make(map[string]bool, len(b.Features)+5)
+	for name, enabled := range b.Features {
+		m[name] = enabled
+	}
+	for name, enabled := range map[ExperimentalFeature]*bool{
+		ExperimentalFeatureVectorStore:             b.VectorStore,
+		ExperimentalFeatureLogsRoute:               b.LogsRoute,
+		ExperimentalFeatureMetrics:                 b.Metrics,
+		ExperimentalFeatureEditDocumentsByFunction: b.EditDocumentsByFunction,
+		ExperimentalFeatureContainsFilter:          b.ContainsFilter,
+	} {
+		if enabled != nil {
+			m[string(name)] = *enabled
+		}
+	}
+	return json.Marshal(m)
+}
+
+// ExperimentalFeaturesResult represents the experimental features result from the API.
+// Features holds every flag returned by the instance, including the ones unknown to this version
+// of the SDK.
+type ExperimentalFeaturesResult struct {
+	VectorStore             bool            `json:"vectorStore"`
+	LogsRoute               bool            `json:"logsRoute"`
+	Metrics                 bool            `json:"metrics"`
+	EditDocumentsByFunction bool            `json:"editDocumentsByFunction"`
+	ContainsFilter          bool            `json:"containsFilter"`
+	Features                map[string]bool `json:"-"`
+}
+
+// Enabled reports whether the feature is enabled
+func (r *ExperimentalFeaturesResult) Enabled(feature ExperimentalFeature) bool {
+	return r.Features[string(feature)]
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (r *ExperimentalFeaturesResult) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*r = ExperimentalFeaturesResult{Features: make(map[string]bool, len(raw))}
+	for name, value := range raw {
+		var enabled bool
+		// only boolean flags are features
+		if err := json.Unmarshal(value, &enabled); err != nil {
+			continue
+		}
+		r.Features[name] = enabled
+
+		switch ExperimentalFeature(name) {
+		case ExperimentalFeatureVectorStore:
+			r.VectorStore = enabled
+		case ExperimentalFeatureLogsRoute:
+			r.LogsRoute = enabled
+		case ExperimentalFeatureMetrics:
+			r.Metrics = enabled
+		case ExperimentalFeatureEditDocumentsByFunction:
+			r.EditDocumentsByFunction = enabled
+		case ExperimentalFeatureContainsFilter:
+			r.ContainsFilter = enabled
+		}
+	}
+	return nil
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (r ExperimentalFeaturesResult) MarshalJSON() ([]byte, error) {
+	m := make(map[string]bool, len(r.Features)+5)
+	for name, enabled := range r.Features {
+		m[name] = enabled
+	}
+	m[string(ExperimentalFeatureVectorStore)] = r.VectorStore
+	m[string(ExperimentalFeatureLogsRoute)] = r.LogsRoute
+	m[string(ExperimentalFeatureMetrics)] = r.Metrics
+	m[string(ExperimentalFeatureEditDocumentsByFunction)] = r.EditDocumentsByFunction
+	m[string(ExperimentalFeatureContainsFilter)] = r.ContainsFilter
+	return json.Marshal(m)
+}
+
+// ExperimentalFeatureError is returned by the methods depending on an experimental feature which
+// is not enabled on the instance. Use errors.Is(err, ErrExperimentalFeatureDisabled) to check for it.
+type ExperimentalFeatureError struct {
+	// Feature is the experimental feature needed
+	Feature ExperimentalFeature
+	// Function is the SDK method which needs the feature
+	Function string
+	// Err is the error returned by meilisearch, nil when the feature was checked before the request
+	Err error
+}
+
+func (e *ExperimentalFeatureError) Error() string {
+	msg := fmt.Sprintf("%s needs the %q experimental feature, enable it with ExperimentalFeatures().Set(%q, true).Update() "+
+		"or create the client with the WithAutoEnableExperimentalFeatures option", e.Function, e.Feature, e.Feature)
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *ExperimentalFeatureError) Unwrap() error {
+	return e.Err
+}
+
+// Is makes errors.Is(err, ErrExperimentalFeatureDisabled) true
+func (e *ExperimentalFeatureError) Is(target error) bool {
+	return target == ErrExperimentalFeatureDisabled
+}
+
 // Type for experimental features with additional client field
 type ExperimentalFeatures struct {
 	client *client
@@ -40,6 +177,27 @@ func (ef *ExperimentalFeatures) SetContainsFilter(containsFilter bool) *Experime
 	return ef
 }
 
+// Set sets any experimental feature by name, including the ones without a dedicated setter
+func (ef *ExperimentalFeatures) Set(feature ExperimentalFeature, enabled bool) *ExperimentalFeatures {
+	switch feature {
+	case ExperimentalFeatureVectorStore:
+		return ef.SetVectorStore(enabled)
+	case ExperimentalFeatureLogsRoute:
+		return ef.SetLogsRoute(enabled)
+	case ExperimentalFeatureMetrics:
+		return ef.SetMetrics(enabled)
+	case ExperimentalFeatureEditDocumentsByFunction:
+		return ef.SetEditDocumentsByFunction(enabled)
+	case ExperimentalFeatureContainsFilter:
+		return ef.SetContainsFilter(enabled)
+	}
+	if ef.Features == nil {
+		ef.Features = map[string]bool{}
+	}
+	ef.Features[string(feature)] = enabled
+	return ef
+}
+
 func (ef *ExperimentalFeatures) Get() (*ExperimentalFeaturesResult, error) {
 	return ef.GetWithContext(context.Background())
 }
@@ -74,6 +232,7 @@ func (ef *ExperimentalFeatures) UpdateWithContext(ctx context.Context) (*Experim
 		Metrics:                 ef.Metrics,
 		EditDocumentsByFunction: ef.EditDocumentsByFunction,
 		ContainsFilter:          ef.ContainsFilter,
+		Features:                ef.Features,
 	}
 	resp := new(ExperimentalFeaturesResult)
 	req := &internalRequest{
@@ -91,3 +250,81 @@ func (ef *ExperimentalFeatures) UpdateWithContext(ctx context.Context) (*Experim
 	}
 	return resp, nil
 }
+
+// requireExperimentalFeature checks the feature is enabled before calling function, the feature is
+// enabled when the client allows it
+func (c *client) requireExperimentalFeature(ctx context.Context, feature ExperimentalFeature, function string) error {
+	features, err := (&ExperimentalFeatures{client: c}).GetWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if features.Enabled(feature) {
+		return nil
+	}
+	if !c.autoEnableFeatures {
+		return &ExperimentalFeatureError{Feature: feature, Function: function}
+	}
+	_, err = (&ExperimentalFeatures{client: c}).Set(feature, true).UpdateWithContext(ctx)
+	return err
+}
+
+// featureNotEnabled reports whether err is the error returned by meilisearch for a request which
+// needs an experimental feature which is not enabled
+func featureNotEnabled(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.Is(APIErrorCodeFeatureNotEnabled)
+}
+
+// containsFilterRegexp matches CONTAINS in operator position, after an attribute and not after
+// another operator as in `title = contains`
+var containsFilterRegexp = regexp.MustCompile(`(?i)[^=<>!\s]\s+CONTAINS\b`)
+
+// unquotedFilter replaces the quoted values of a filter expression with spaces so that their
+// content is not taken for operators
+func unquotedFilter(filter string) string {
+	b := []byte(filter)
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if c != '"' && c != '\'' {
+			continue
+		}
+		for i++; i < len(b) && b[i] != c; i++ {
+			if b[i] == '\\' && i+1 < len(b) {
+				b[i] = ' '
+				i++
+			}
+			b[i] = ' '
+		}
+	}
+	return string(b)
+}
+
+// filterFeature returns the experimental feature needed by a filter expression
+func filterFeature(filter interface{}) ExperimentalFeature {
+	var found bool
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch f := v.(type) {
+		case string:
+			found = found || containsFilterRegexp.MatchString(unquotedFilter(f))
+		case []string:
+			for _, s := range f {
+				walk(s)
+			}
+		case [][]string:
+			for _, s := range f {
+				walk(s)
+			}
+		case []interface{}:
+			for _, s := range f {
+				walk(s)
+			}
+		}
+	}
+	walk(filter)
+
+	if found {
+		return ExperimentalFeatureContainsFilter
+	}
+	return ""
+}
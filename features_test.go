@@ -1,7 +1,12 @@
 package meilisearch
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -75,3 +80,91 @@ func TestUpdate_ExperimentalFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestExperimentalFeatures_UnknownFlags(t *testing.T) {
+	var res ExperimentalFeaturesResult
+	require.NoError(t, json.Unmarshal([]byte(`{"metrics": true, "network": true, "chatCompletions": false, "limit": 3}`), &res))
+	require.True(t, res.Metrics)
+	require.True(t, res.Enabled(ExperimentalFeatureMetrics))
+	require.True(t, res.Enabled("network"))
+	require.False(t, res.Enabled("chatCompletions"))
+	require.Equal(t, map[string]bool{"metrics": true, "network": true, "chatCompletions": false}, res.Features)
+
+	b, err := json.Marshal(res)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"vectorStore": false, "logsRoute": false, "metrics": true, "editDocumentsByFunction": false,
+		"containsFilter": false, "network": true, "chatCompletions": false}`, string(b))
+
+	ef := (&ExperimentalFeatures{}).Set(ExperimentalFeatureLogsRoute, true).Set("network", false)
+	require.True(t, *ef.LogsRoute)
+	b, err = json.Marshal(ef.ExperimentalFeaturesBase)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"logsRoute": true, "network": false}`, string(b))
+}
+
+func TestExperimentalFeatures_Gating(t *testing.T) {
+	enabled := map[string]bool{}
+	var patches []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/experimental-features":
+			if r.Method == http.MethodPatch {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				patches = append(patches, string(body))
+				require.NoError(t, json.Unmarshal(body, &enabled))
+			}
+			b, err := json.Marshal(enabled)
+			require.NoError(t, err)
+			_, _ = w.Write(b)
+		case "/indexes/movies/search":
+			if !enabled["containsFilter"] {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message": "Using CONTAINS in a filter requires enabling the containsFilter experimental feature", "code": "feature_not_enabled", "type": "invalid_request", "link": ""}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"hits": []}`))
+		}
+	}))
+	defer ts.Close()
+
+	_, err := New(ts.URL).Index("movies").Search("", &SearchRequest{Filter: "title CONTAINS car"})
+	require.ErrorIs(t, err, ErrExperimentalFeatureDisabled)
+	var featureErr *ExperimentalFeatureError
+	require.ErrorAs(t, err, &featureErr)
+	require.Equal(t, ExperimentalFeatureContainsFilter, featureErr.Feature)
+	require.Equal(t, "Search", featureErr.Function)
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, APIErrorCodeFeatureNotEnabled, apiErr.MeilisearchApiError.Code)
+	require.Empty(t, patches)
+
+	// the option does not leak to the clients created afterwards
+	client := New(ts.URL, WithAutoEnableExperimentalFeatures())
+	_, err = New(ts.URL).Index("movies").Search("", &SearchRequest{Filter: "title CONTAINS car"})
+	require.ErrorIs(t, err, ErrExperimentalFeatureDisabled)
+
+	_, err = client.Index("movies").Search("", &SearchRequest{Filter: []string{"genre = drama", "title NOT CONTAINS car"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"containsFilter":true}`}, patches)
+
+	require.NoError(t, New(ts.URL).(*meilisearch).client.requireExperimentalFeature(
+		context.Background(), ExperimentalFeatureContainsFilter, "Search"))
+	err = New(ts.URL).(*meilisearch).client.requireExperimentalFeature(context.Background(), ExperimentalFeatureMetrics, "GetMetrics")
+	require.ErrorIs(t, err, ErrExperimentalFeatureDisabled)
+	require.EqualError(t, err, `GetMetrics needs the "metrics" experimental feature, enable it with `+
+		`ExperimentalFeatures().Set("metrics", true).Update() or create the client with the WithAutoEnableExperimentalFeatures option`)
+}
+
+func TestFilterFeature(t *testing.T) {
+	require.Equal(t, ExperimentalFeature(""), filterFeature(nil))
+	require.Equal(t, ExperimentalFeature(""), filterFeature("title = containsfoo"))
+	require.Equal(t, ExperimentalFeatureContainsFilter, filterFeature("title contains foo"))
+	require.Equal(t, ExperimentalFeature(""), filterFeature(`description = "contains nuts"`))
+	require.Equal(t, ExperimentalFeature(""), filterFeature(`description = 'a \' contains b'`))
+	require.Equal(t, ExperimentalFeature(""), filterFeature("description = contains"))
+	require.Equal(t, ExperimentalFeatureContainsFilter, filterFeature(`description = "nuts" OR description CONTAINS "contains"`))
+	require.Equal(t, ExperimentalFeatureContainsFilter, filterFeature([][]string{{"a = 1"}, {"b CONTAINS 2"}}))
+	require.Equal(t, ExperimentalFeatureContainsFilter, filterFeature([]interface{}{"a = 1", []interface{}{"b CONTAINS 2"}}))
+}
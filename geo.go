@@ -0,0 +1,144 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// GeoPoint is the value of the `_geo` field of a document. Add it to your documents to make them
+// usable with geo filters and geo sort:
+//
+//	type Store struct {
+//		ID  string    `json:"id"`
+//		Geo *GeoPoint `json:"_geo,omitempty"`
+//	}
+//
+// Documentation: https://www.meilisearch.com/docs/learn/filtering_and_sorting/geosearch
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// NewGeoPoint returns a validated GeoPoint
+func NewGeoPoint(lat, lng float64) (GeoPoint, error) {
+	p := GeoPoint{Lat: lat, Lng: lng}
+	if err := p.Validate(); err != nil {
+		return GeoPoint{}, err
+	}
+	return p, nil
+}
+
+// Validate checks the latitude is within [-90, 90] and the longitude within [-180, 180]
+func (p GeoPoint) Validate() error {
+	if p.Lat < -90 || p.Lat > 90 || p.Lat != p.Lat {
+		return fmt.Errorf("%w: latitude %v must be between -90 and 90", ErrInvalidGeoPoint, p.Lat)
+	}
+	if p.Lng < -180 || p.Lng > 180 || p.Lng != p.Lng {
+		return fmt.Errorf("%w: longitude %v must be between -180 and 180", ErrInvalidGeoPoint, p.Lng)
+	}
+	return nil
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface, meilisearch accepts coordinates given as
+// numbers or strings.
+func (p *GeoPoint) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Lat json.Number `json:"lat"`
+		Lng json.Number `json:"lng"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	lat, err := raw.Lat.Float64()
+	if err != nil {
+		return fmt.Errorf("%w: invalid latitude %q", ErrInvalidGeoPoint, raw.Lat)
+	}
+	lng, err := raw.Lng.Float64()
+	if err != nil {
+		return fmt.Errorf("%w: invalid longitude %q", ErrInvalidGeoPoint, raw.Lng)
+	}
+
+	p.Lat, p.Lng = lat, lng
+	return nil
+}
+
+func (p GeoPoint) coordinates() string {
+	return formatGeoFloat(p.Lat) + ", " + formatGeoFloat(p.Lng)
+}
+
+func formatGeoFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// GeoRadius builds a `_geoRadius` filter matching the documents located at most distance meters
+// from center. The result can be used as SearchRequest.Filter or combined with other filters.
+func GeoRadius(center GeoPoint, distance float64) (string, error) {
+	if err := center.Validate(); err != nil {
+		return "", err
+	}
+	if distance < 0 || distance != distance {
+		return "", fmt.Errorf("%w: distance %v must be positive", ErrInvalidGeoPoint, distance)
+	}
+	return "_geoRadius(" + center.coordinates() + ", " + formatGeoFloat(distance) + ")", nil
+}
+
+// GeoBoundingBox builds a `_geoBoundingBox` filter matching the documents located in the
+// rectangle delimited by its top right and bottom left corners.
+func GeoBoundingBox(topRight, bottomLeft GeoPoint) (string, error) {
+	if err := topRight.Validate(); err != nil {
+		return "", err
+	}
+	if err := bottomLeft.Validate(); err != nil {
+		return "", err
+	}
+	if topRight.Lat < bottomLeft.Lat {
+		return "", fmt.Errorf("%w: top right latitude %v is below bottom left latitude %v",
+			ErrInvalidGeoPoint, topRight.Lat, bottomLeft.Lat)
+	}
+	return "_geoBoundingBox([" + topRight.coordinates() + "], [" + bottomLeft.coordinates() + "])", nil
+}
+
+// GeoSort builds a `_geoPoint` sort expression ordering the documents by distance to the given
+// point, closest first when ascending is true. The result can be added to SearchRequest.Sort.
+func GeoSort(point GeoPoint, ascending bool) (string, error) {
+	if err := point.Validate(); err != nil {
+		return "", err
+	}
+	direction := "desc"
+	if ascending {
+		direction = "asc"
+	}
+	return "_geoPoint(" + point.coordinates() + "):" + direction, nil
+}
+
+type geoHit struct {
+	Geo         *GeoPoint `json:"_geo"`
+	GeoDistance *float64  `json:"_geoDistance"`
+}
+
+// DecodeGeoDistance extracts the `_geoDistance` of a search hit, in meters. It is only returned
+// when the search request sorts or filters on `_geoPoint`, `_geoRadius` or `_geoBoundingBox`.
+func DecodeGeoDistance(hit interface{}) (float64, error) {
+	h := new(geoHit)
+	if err := decodeHit(hit, h, EasyJSONCodec); err != nil {
+		return 0, err
+	}
+	if h.GeoDistance == nil {
+		return 0, ErrNoGeoDistance
+	}
+	return *h.GeoDistance, nil
+}
+
+// DecodeGeoPoint extracts the `_geo` field of a search hit
+func DecodeGeoPoint(hit interface{}) (*GeoPoint, error) {
+	h := new(geoHit)
+	if err := decodeHit(hit, h, EasyJSONCodec); err != nil {
+		return nil, err
+	}
+	if h.Geo == nil {
+		return nil, ErrNoGeoPoint
+	}
+	return h.Geo, nil
+}
@@ -0,0 +1,87 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoPoint_Validate(t *testing.T) {
+	_, err := NewGeoPoint(48.8566, 2.3522)
+	require.NoError(t, err)
+
+	for _, p := range []GeoPoint{{Lat: 90.1}, {Lat: -91}, {Lng: 180.5}, {Lng: -181}} {
+		_, err := NewGeoPoint(p.Lat, p.Lng)
+		require.ErrorIs(t, err, ErrInvalidGeoPoint)
+	}
+}
+
+func TestGeoPoint_UnmarshalJSON(t *testing.T) {
+	var doc struct {
+		Geo *GeoPoint `json:"_geo"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"_geo": {"lat": 45.4628, "lng": 9.1885}}`), &doc))
+	require.Equal(t, &GeoPoint{Lat: 45.4628, Lng: 9.1885}, doc.Geo)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"_geo": {"lat": "45.4628", "lng": "-9.1885"}}`), &doc))
+	require.Equal(t, &GeoPoint{Lat: 45.4628, Lng: -9.1885}, doc.Geo)
+
+	require.Error(t, json.Unmarshal([]byte(`{"_geo": {"lat": "north", "lng": 1}}`), &doc))
+
+	b, err := json.Marshal(GeoPoint{Lat: 1.5, Lng: -2})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"lat": 1.5, "lng": -2}`, string(b))
+}
+
+func TestGeoFilters(t *testing.T) {
+	paris := GeoPoint{Lat: 48.8566, Lng: 2.3522}
+
+	f, err := GeoRadius(paris, 2000)
+	require.NoError(t, err)
+	require.Equal(t, "_geoRadius(48.8566, 2.3522, 2000)", f)
+
+	_, err = GeoRadius(paris, -1)
+	require.ErrorIs(t, err, ErrInvalidGeoPoint)
+
+	f, err = GeoBoundingBox(GeoPoint{Lat: 45.494181, Lng: 9.214024}, GeoPoint{Lat: 45.449484, Lng: 9.179175})
+	require.NoError(t, err)
+	require.Equal(t, "_geoBoundingBox([45.494181, 9.214024], [45.449484, 9.179175])", f)
+
+	_, err = GeoBoundingBox(GeoPoint{Lat: 1}, GeoPoint{Lat: 2})
+	require.ErrorIs(t, err, ErrInvalidGeoPoint)
+
+	s, err := GeoSort(paris, true)
+	require.NoError(t, err)
+	require.Equal(t, "_geoPoint(48.8566, 2.3522):asc", s)
+
+	s, err = GeoSort(paris, false)
+	require.NoError(t, err)
+	require.Equal(t, "_geoPoint(48.8566, 2.3522):desc", s)
+
+	_, err = GeoSort(GeoPoint{Lat: 100}, true)
+	require.ErrorIs(t, err, ErrInvalidGeoPoint)
+}
+
+func TestDecodeGeoDistance(t *testing.T) {
+	hit := map[string]interface{}{
+		"id":           "1",
+		"_geo":         map[string]interface{}{"lat": 48.8566, "lng": 2.3522},
+		"_geoDistance": float64(1532),
+	}
+
+	d, err := DecodeGeoDistance(hit)
+	require.NoError(t, err)
+	require.Equal(t, float64(1532), d)
+
+	p, err := DecodeGeoPoint(hit)
+	require.NoError(t, err)
+	require.Equal(t, &GeoPoint{Lat: 48.8566, Lng: 2.3522}, p)
+
+	_, err = DecodeGeoDistance(map[string]interface{}{"id": "1"})
+	require.ErrorIs(t, err, ErrNoGeoDistance)
+
+	_, err = DecodeGeoPoint(map[string]interface{}{"id": "1"})
+	require.ErrorIs(t, err, ErrNoGeoPoint)
+}
@@ -1,10 +1,18 @@
 module github.com/meilisearch/meilisearch-go
 
-go 1.16
+go 1.24
 
 require (
 	github.com/andybalholm/brotli v1.1.1
 	github.com/golang-jwt/jwt/v4 v4.5.1
+	github.com/klauspost/compress v1.18.0
 	github.com/mailru/easyjson v0.9.0
 	github.com/stretchr/testify v1.8.2
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -86,6 +86,9 @@ func encodeTasksQuery(param *TasksQuery, req *internalRequest) {
 	if !param.AfterFinishedAt.IsZero() {
 		req.withQueryParams["afterFinishedAt"] = *formatDate(param.AfterFinishedAt, false)
 	}
+	if param.Reverse {
+		req.withQueryParams["reverse"] = "true"
+	}
 }
 
 func formatDate(date time.Time, _ bool) *string {
@@ -134,3 +137,28 @@ func sendCsvRecords(ctx context.Context, documentsCsvFunc func(ctx context.Conte
 	}
 	return resp, nil
 }
+
+// decodeHit decodes a search hit into vPtr with codec. The hit can be an element of SearchResponse.Hits,
+// raw JSON or any value that can be encoded in JSON.
+func decodeHit(hit interface{}, vPtr interface{}, codec JSONCodec) error {
+	var data []byte
+	switch h := hit.(type) {
+	case []byte:
+		data = h
+	case json.RawMessage:
+		data = h
+	case *json.RawMessage:
+		data = *h
+	default:
+		b, err := codec.Marshal(hit)
+		if err != nil {
+			return fmt.Errorf("unable to encode hit: %w", err)
+		}
+		data = b
+	}
+
+	if err := codec.Unmarshal(data, vPtr); err != nil {
+		return fmt.Errorf("unable to decode hit: %w", err)
+	}
+	return nil
+}
@@ -55,6 +55,7 @@ func TestEncodeTasksQuery(t *testing.T) {
 		AfterStartedAt:   time.Now().Add(-40 * time.Hour),
 		BeforeFinishedAt: time.Now().Add(-50 * time.Hour),
 		AfterFinishedAt:  time.Now().Add(-60 * time.Hour),
+		Reverse:          true,
 	}
 	req := &internalRequest{}
 	req.init()
@@ -75,6 +76,7 @@ func TestEncodeTasksQuery(t *testing.T) {
 		"afterStartedAt":   formatDateForComparison(param.AfterStartedAt),
 		"beforeFinishedAt": formatDateForComparison(param.BeforeFinishedAt),
 		"afterFinishedAt":  formatDateForComparison(param.AfterFinishedAt),
+		"reverse":          "true",
 	}
 
 	for k, v := range expectedParams {
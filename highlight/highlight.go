@@ -0,0 +1,323 @@
+// Package highlight post-processes the `_formatted` and `_matchesPosition` objects of the search hits:
+// it splits highlighted attributes into segments safe for HTML escaping, converts match byte offsets
+// into rune offsets and snippets, and highlights texts client side from their match positions.
+package highlight
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrNoFormatted       = errors.New("hit has no formatted attributes, set AttributesToHighlight or AttributesToCrop in the search request")
+	ErrNoMatchesPosition = errors.New("hit has no matches position, set ShowMatchesPosition in the search request")
+)
+
+const (
+	// DefaultPreTag is the tag inserted by meilisearch before a highlighted match when
+	// meilisearch.SearchRequest.HighlightPreTag is empty
+	DefaultPreTag = "<em>"
+	// DefaultPostTag is the tag inserted by meilisearch after a highlighted match when
+	// meilisearch.SearchRequest.HighlightPostTag is empty
+	DefaultPostTag = "</em>"
+	// DefaultCropMarker is the marker inserted by meilisearch around a cropped text when
+	// meilisearch.SearchRequest.CropMarker is empty
+	DefaultCropMarker = "…"
+)
+
+// Segment is a part of a text that is either plain or matching the query
+type Segment struct {
+	Text        string
+	Highlighted bool
+}
+
+// Text is a text split into plain and highlighted segments
+type Text []Segment
+
+// String returns the text without any highlight tag
+func (h Text) String() string {
+	b := new(strings.Builder)
+	for _, s := range h {
+		b.WriteString(s.Text)
+	}
+	return b.String()
+}
+
+// HTML returns the text with every segment HTML-escaped and the highlighted ones wrapped in
+// the given element (`mark` when empty). It is safe to embed the result in a HTML page even
+// when the document contains markup.
+func (h Text) HTML(element string) string {
+	if element == "" {
+		element = "mark"
+	}
+
+	b := new(strings.Builder)
+	for _, s := range h {
+		if !s.Highlighted {
+			b.WriteString(html.EscapeString(s.Text))
+			continue
+		}
+		b.WriteString("<" + element + ">")
+		b.WriteString(html.EscapeString(s.Text))
+		b.WriteString("</" + element + ">")
+	}
+	return b.String()
+}
+
+// Parse splits a `_formatted` value into segments using the tags given in the search
+// request. Empty tags fall back to DefaultPreTag and DefaultPostTag.
+//
+// Meilisearch does not escape the documents content, so pick tags that cannot appear in your
+// documents to avoid ambiguities.
+func Parse(formatted, preTag, postTag string) Text {
+	if preTag == "" {
+		preTag = DefaultPreTag
+	}
+	if postTag == "" {
+		postTag = DefaultPostTag
+	}
+
+	segments := make(Text, 0)
+	for formatted != "" {
+		start := strings.Index(formatted, preTag)
+		if start < 0 {
+			segments = append(segments, Segment{Text: formatted})
+			break
+		}
+		if start > 0 {
+			segments = append(segments, Segment{Text: formatted[:start]})
+		}
+		formatted = formatted[start+len(preTag):]
+
+		end := strings.Index(formatted, postTag)
+		if end < 0 {
+			end = len(formatted)
+		}
+		if end > 0 {
+			segments = append(segments, Segment{Text: formatted[:end], Highlighted: true})
+		}
+		formatted = formatted[end:]
+		if strings.HasPrefix(formatted, postTag) {
+			formatted = formatted[len(postTag):]
+		}
+	}
+	return segments
+}
+
+// FormattedHit is the decoded `_formatted` object of a hit indexed by attribute path.
+//
+// Nested objects are flattened using dotted paths (`info.comment`) and array elements are keyed
+// by their position (`genres.0`). Non-string values are converted to plain text.
+type FormattedHit map[string]Text
+
+type formattedHit struct {
+	Formatted map[string]interface{} `json:"_formatted"`
+}
+
+// DecodeFormatted extracts the `_formatted` object of a search hit, returned when AttributesToHighlight
+// or AttributesToCrop is set in the meilisearch.SearchRequest, and splits every attribute into
+// highlighted segments.
+func DecodeFormatted(hit interface{}, preTag, postTag string) (FormattedHit, error) {
+	h := new(formattedHit)
+	if err := decodeHit(hit, h); err != nil {
+		return nil, err
+	}
+	if h.Formatted == nil {
+		return nil, ErrNoFormatted
+	}
+
+	res := make(FormattedHit)
+	flattenFormatted(res, "", h.Formatted, preTag, postTag)
+	return res, nil
+}
+
+func flattenFormatted(res FormattedHit, path string, value interface{}, preTag, postTag string) {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			flattenFormatted(res, join(key), val, preTag, postTag)
+		}
+	case []interface{}:
+		for i, val := range v {
+			flattenFormatted(res, join(strconv.Itoa(i)), val, preTag, postTag)
+		}
+	case string:
+		res[path] = Parse(v, preTag, postTag)
+	case nil:
+		res[path] = Text{}
+	case float64:
+		res[path] = Text{{Text: strconv.FormatFloat(v, 'f', -1, 64)}}
+	case bool:
+		res[path] = Text{{Text: strconv.FormatBool(v)}}
+	}
+}
+
+// MatchPosition is the location of a query term in an attribute, as returned in `_matchesPosition`
+// when ShowMatchesPosition is set in the meilisearch.SearchRequest. Start and Length are expressed in
+// bytes of the UTF-8 encoded attribute.
+type MatchPosition struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+	// Indices is the position of the matching element when the attribute is an array
+	Indices []int `json:"indices,omitempty"`
+}
+
+// MatchesPosition is the decoded `_matchesPosition` object of a hit, indexed by attribute path
+type MatchesPosition map[string][]MatchPosition
+
+type matchesPositionHit struct {
+	MatchesPosition MatchesPosition `json:"_matchesPosition"`
+}
+
+// DecodeMatchesPosition extracts the `_matchesPosition` object of a search hit
+func DecodeMatchesPosition(hit interface{}) (MatchesPosition, error) {
+	h := new(matchesPositionHit)
+	if err := decodeHit(hit, h); err != nil {
+		return nil, err
+	}
+	if h.MatchesPosition == nil {
+		return nil, ErrNoMatchesPosition
+	}
+	return h.MatchesPosition, nil
+}
+
+// byteRange returns the match boundaries clamped to text and aligned on runes
+func (m MatchPosition) byteRange(text string) (start, end int) {
+	start, end = m.Start, m.Start+m.Length
+	if start < 0 {
+		start = 0
+	}
+	if start > len(text) {
+		start = len(text)
+	}
+	if end > len(text) {
+		end = len(text)
+	}
+	if end < start {
+		end = start
+	}
+	for start > 0 && start < len(text) && !utf8.RuneStart(text[start]) {
+		start--
+	}
+	for end < len(text) && !utf8.RuneStart(text[end]) {
+		end++
+	}
+	return start, end
+}
+
+// RuneOffsets converts the byte offsets of the match into rune offsets in text, the returned range
+// is [start, end). Offsets falling in the middle of a multi-byte character are widened to include it.
+func (m MatchPosition) RuneOffsets(text string) (start, end int) {
+	bs, be := m.byteRange(text)
+	start = utf8.RuneCountInString(text[:bs])
+	return start, start + utf8.RuneCountInString(text[bs:be])
+}
+
+// Snippet returns the match surrounded by at most context runes on each side. cropMarker is added
+// where the text was cut, DefaultCropMarker is used when it is empty.
+func (m MatchPosition) Snippet(text string, context int, cropMarker string) string {
+	if cropMarker == "" {
+		cropMarker = DefaultCropMarker
+	}
+
+	runes := []rune(text)
+	start, end := m.RuneOffsets(text)
+
+	from, to := start-context, end+context
+	if from < 0 {
+		from = 0
+	}
+	if to > len(runes) {
+		to = len(runes)
+	}
+
+	snippet := string(runes[from:to])
+	if from > 0 {
+		snippet = cropMarker + snippet
+	}
+	if to < len(runes) {
+		snippet += cropMarker
+	}
+	return snippet
+}
+
+// FromPositions highlights text client side from its `_matchesPosition` entries, which avoids
+// asking meilisearch for `_formatted` when only the positions are needed. Overlapping matches are
+// merged.
+func FromPositions(text string, positions []MatchPosition) Text {
+	type span struct{ start, end int }
+
+	spans := make([]span, 0, len(positions))
+	for _, p := range positions {
+		start, end := p.byteRange(text)
+		if start == end {
+			continue
+		}
+		spans = append(spans, span{start, end})
+	}
+	sort.Slice(spans, func(a, b int) bool {
+		return spans[a].start < spans[b].start
+	})
+
+	segments := make(Text, 0, 2*len(spans)+1)
+	cursor := 0
+	for _, s := range spans {
+		if s.end <= cursor {
+			continue
+		}
+		if s.start < cursor {
+			// overlapping match, extend the previous highlighted segment
+			last := &segments[len(segments)-1]
+			last.Text += text[cursor:s.end]
+			cursor = s.end
+			continue
+		}
+		if s.start > cursor {
+			segments = append(segments, Segment{Text: text[cursor:s.start]})
+		}
+		segments = append(segments, Segment{Text: text[s.start:s.end], Highlighted: true})
+		cursor = s.end
+	}
+	if cursor < len(text) {
+		segments = append(segments, Segment{Text: text[cursor:]})
+	}
+	return segments
+}
+
+// decodeHit decodes a search hit into vPtr. The hit can be an element of SearchResponse.Hits,
+// raw JSON or any value that can be encoded in JSON.
+func decodeHit(hit interface{}, vPtr interface{}) error {
+	var data []byte
+	switch h := hit.(type) {
+	case []byte:
+		data = h
+	case json.RawMessage:
+		data = h
+	case *json.RawMessage:
+		data = *h
+	default:
+		b, err := json.Marshal(hit)
+		if err != nil {
+			return fmt.Errorf("unable to encode hit: %w", err)
+		}
+		data = b
+	}
+
+	if err := json.Unmarshal(data, vPtr); err != nil {
+		return fmt.Errorf("unable to decode hit: %w", err)
+	}
+	return nil
+}
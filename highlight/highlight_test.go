@@ -0,0 +1,111 @@
+package highlight
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHighlighted(t *testing.T) {
+	got := Parse("The <em>Little</em> <em>Prince</em> <b>", "", "")
+	require.Equal(t, Text{
+		{Text: "The "},
+		{Text: "Little", Highlighted: true},
+		{Text: " "},
+		{Text: "Prince", Highlighted: true},
+		{Text: " <b>"},
+	}, got)
+	require.Equal(t, "The Little Prince <b>", got.String())
+	require.Equal(t, "The <mark>Little</mark> <mark>Prince</mark> &lt;b&gt;", got.HTML(""))
+
+	got = Parse("[[星の]]王子さま", "[[", "]]")
+	require.Equal(t, Text{
+		{Text: "星の", Highlighted: true},
+		{Text: "王子さま"},
+	}, got)
+
+	// unterminated tag highlights until the end
+	got = Parse("a <em>b", "", "")
+	require.Equal(t, Text{{Text: "a "}, {Text: "b", Highlighted: true}}, got)
+}
+
+func TestDecodeFormatted(t *testing.T) {
+	hit := json.RawMessage(`{
+		"id": 1,
+		"_formatted": {
+			"id": "1",
+			"title": "Le Petit <em>Prince</em>",
+			"info": {"comment": "A <em>french</em> book", "reviewNb": 600},
+			"genres": ["<em>Tale</em>", "Novel"]
+		}
+	}`)
+
+	got, err := DecodeFormatted(hit, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "Le Petit Prince", got["title"].String())
+	require.Equal(t, "A <mark>french</mark> book", got["info.comment"].HTML(""))
+	require.Equal(t, "600", got["info.reviewNb"].String())
+	require.True(t, got["genres.0"][0].Highlighted)
+	require.Equal(t, Text{{Text: "Novel"}}, got["genres.1"])
+
+	_, err = DecodeFormatted(map[string]interface{}{"id": 1}, "", "")
+	require.ErrorIs(t, err, ErrNoFormatted)
+}
+
+func TestMatchPosition(t *testing.T) {
+	text := "Le Petit Prince, 星の王子さま"
+	hit := map[string]interface{}{
+		"_matchesPosition": map[string]interface{}{
+			"title": []interface{}{
+				map[string]interface{}{"start": 9, "length": 6},
+				map[string]interface{}{"start": 17, "length": 6},
+			},
+		},
+	}
+
+	positions, err := DecodeMatchesPosition(hit)
+	require.NoError(t, err)
+	require.Len(t, positions["title"], 2)
+
+	start, end := positions["title"][0].RuneOffsets(text)
+	require.Equal(t, 9, start)
+	require.Equal(t, 15, end)
+
+	// 6 bytes are two japanese characters
+	start, end = positions["title"][1].RuneOffsets(text)
+	require.Equal(t, 17, start)
+	require.Equal(t, 19, end)
+
+	// offsets in the middle of a character are widened
+	start, end = MatchPosition{Start: 18, Length: 1}.RuneOffsets(text)
+	require.Equal(t, 17, start)
+	require.Equal(t, 18, end)
+
+	require.Equal(t, "…tit Prince, 星の…", positions["title"][0].Snippet(text, 4, ""))
+	require.Equal(t, "…e, 星の王子さ…", positions["title"][1].Snippet(text, 3, ""))
+
+	require.Equal(t, Text{
+		{Text: "Le Petit "},
+		{Text: "Prince", Highlighted: true},
+		{Text: ", "},
+		{Text: "星の", Highlighted: true},
+		{Text: "王子さま"},
+	}, FromPositions(text, positions["title"]))
+
+	_, err = DecodeMatchesPosition(map[string]interface{}{"id": 1})
+	require.ErrorIs(t, err, ErrNoMatchesPosition)
+}
+
+func TestHighlightPositions_Overlap(t *testing.T) {
+	got := FromPositions("abcdef", []MatchPosition{
+		{Start: 3, Length: 2},
+		{Start: 1, Length: 3},
+		{Start: 10, Length: 2},
+	})
+	require.Equal(t, Text{
+		{Text: "a"},
+		{Text: "bcde", Highlighted: true},
+		{Text: "f"},
+	}, got)
+}
@@ -5,13 +5,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math"
 	"net/http"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func (i *index) AddDocuments(documentsPtr interface{}, primaryKey ...string) (*TaskInfo, error) {
@@ -61,13 +65,8 @@ func (i *index) AddDocumentsCsvFromReader(documents io.Reader, options *CsvDocum
 }
 
 func (i *index) AddDocumentsCsvFromReaderWithContext(ctx context.Context, documents io.Reader, options *CsvDocumentsQuery) (resp *TaskInfo, err error) {
-	// Using io.Reader would avoid JSON conversion in Client.sendRequest(), but
-	// read content to memory anyway because of problems with streamed bodies
-	data, err := io.ReadAll(documents)
-	if err != nil {
-		return nil, fmt.Errorf("could not read documents: %w", err)
-	}
-	return i.addDocuments(ctx, data, contentTypeCSV, transformCsvDocumentsQueryToMap(options))
+	// The documents are streamed, the request is retried only if documents is an io.Seeker, eg. an *os.File
+	return i.addDocuments(ctx, documents, contentTypeCSV, transformCsvDocumentsQueryToMap(options))
 }
 
 func (i *index) AddDocumentsNdjson(documents []byte, primaryKey ...string) (*TaskInfo, error) {
@@ -165,13 +164,8 @@ func (i *index) AddDocumentsNdjsonFromReader(documents io.Reader, primaryKey ...
 }
 
 func (i *index) AddDocumentsNdjsonFromReaderWithContext(ctx context.Context, documents io.Reader, primaryKey ...string) (resp *TaskInfo, err error) {
-	// Using io.Reader would avoid JSON conversion in Client.sendRequest(), but
-	// read content to memory anyway because of problems with streamed bodies
-	data, err := io.ReadAll(documents)
-	if err != nil {
-		return nil, fmt.Errorf("could not read documents: %w", err)
-	}
-	return i.addDocuments(ctx, data, contentTypeNDJSON, transformStringVariadicToMap(primaryKey...))
+	// The documents are streamed, the request is retried only if documents is an io.Seeker, eg. an *os.File
+	return i.addDocuments(ctx, documents, contentTypeNDJSON, transformStringVariadicToMap(primaryKey...))
 }
 
 func (i *index) UpdateDocuments(documentsPtr interface{}, primaryKey ...string) (*TaskInfo, error) {
@@ -237,6 +231,7 @@ func (i *index) UpdateDocumentsByFunctionWithContext(ctx context.Context, req *U
 		contentType:         contentTypeJSON,
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateDocumentsByFunction",
+		experimentalFeature: ExperimentalFeatureEditDocumentsByFunction,
 	}
 	if err := i.client.executeRequest(ctx, r); err != nil {
 		return nil, err
@@ -244,6 +239,192 @@ func (i *index) UpdateDocumentsByFunctionWithContext(ctx context.Context, req *U
 	return resp, nil
 }
 
+func (i *index) PatchDocuments(patch *DocumentPatch, identifiers ...string) (*TaskInfo, error) {
+	return i.PatchDocumentsWithContext(context.Background(), patch, identifiers...)
+}
+
+func (i *index) PatchDocumentsWithContext(ctx context.Context, patch *DocumentPatch, identifiers ...string) (*TaskInfo, error) {
+	if err := patch.validate(); err != nil {
+		return nil, err
+	}
+	if len(identifiers) == 0 {
+		return nil, ErrNoDocumentIdentifiers
+	}
+
+	primaryKey := i.primaryKey
+	if primaryKey == "" {
+		pk, err := i.FetchPrimaryKeyWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		primaryKey = *pk
+	}
+
+	if patch.NeedsFunction() {
+		// the documents are selected with a filter on the primary key
+		filterable, err := i.GetFilterableAttributesWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !isFilterable(primaryKey, filterable) {
+			return nil, fmt.Errorf("%w: %q", ErrPrimaryKeyNotFilterable, primaryKey)
+		}
+		req, err := patch.Function(filterIn(primaryKey, identifiers))
+		if err != nil {
+			return nil, err
+		}
+		return i.UpdateDocumentsByFunctionWithContext(ctx, req)
+	}
+
+	docs, err := patch.Documents(primaryKey, identifiers...)
+	if err != nil {
+		return nil, err
+	}
+	return i.updateDocuments(ctx, docs, contentTypeJSON, nil)
+}
+
+func (i *index) PatchDocumentsByFilter(patch *DocumentPatch, filter string) (*TaskInfo, error) {
+	return i.PatchDocumentsByFilterWithContext(context.Background(), patch, filter)
+}
+
+func (i *index) PatchDocumentsByFilterWithContext(ctx context.Context, patch *DocumentPatch, filter string) (*TaskInfo, error) {
+	req, err := patch.Function(filter)
+	if err != nil {
+		return nil, err
+	}
+	return i.UpdateDocumentsByFunctionWithContext(ctx, req)
+}
+
+func (i *index) EditDocuments(fn *EditFunction) (*TaskInfo, error) {
+	return i.EditDocumentsWithContext(context.Background(), fn)
+}
+
+func (i *index) EditDocumentsWithContext(ctx context.Context, fn *EditFunction) (*TaskInfo, error) {
+	req, err := fn.Request()
+	if err != nil {
+		return nil, err
+	}
+	if err := i.client.requireExperimentalFeature(ctx, ExperimentalFeatureEditDocumentsByFunction, "EditDocuments"); err != nil {
+		return nil, err
+	}
+	return i.UpdateDocumentsByFunctionWithContext(ctx, req)
+}
+
+func (i *index) DryRunEditDocuments(fn *EditFunction, sampleSize int64) (*EditDryRunResult, error) {
+	return i.DryRunEditDocumentsWithContext(context.Background(), fn, sampleSize)
+}
+
+func (i *index) DryRunEditDocumentsWithContext(ctx context.Context, fn *EditFunction, sampleSize int64) (result *EditDryRunResult, err error) {
+	req, err := fn.Request()
+	if err != nil {
+		return nil, err
+	}
+	if err := i.client.requireExperimentalFeature(ctx, ExperimentalFeatureEditDocumentsByFunction, "DryRunEditDocuments"); err != nil {
+		return nil, err
+	}
+	if sampleSize <= 0 {
+		sampleSize = DefaultEditDryRunSampleSize
+	}
+
+	primaryKey, err := i.FetchPrimaryKeyWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filterable, err := i.GetFilterableAttributesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := &DocumentsQuery{Limit: sampleSize}
+	if req.Filter != "" {
+		query.Filter = req.Filter
+	}
+	sample := new(DocumentsResult)
+	if err := i.GetDocumentsWithContext(ctx, query, sample); err != nil {
+		return nil, err
+	}
+
+	result = &EditDryRunResult{Request: req, Sampled: len(sample.Results)}
+	if len(sample.Results) == 0 {
+		return result, nil
+	}
+
+	// the function runs on a copy of the sample, the filter was already applied when fetching it
+	scratch := &index{client: i.client, uid: i.uid + "-dry-run-" + strconv.FormatInt(time.Now().UnixNano(), 36)}
+	defer func() {
+		if cleanupErr := scratch.deleteScratch(context.WithoutCancel(ctx)); cleanupErr != nil {
+			i.client.log(ctx, slog.LevelWarn, "meilisearch dry run index not deleted", &Error{
+				Function: "DryRunEditDocuments",
+				Method:   http.MethodDelete,
+				Endpoint: "/indexes/" + scratch.uid,
+			}, slog.String("index", scratch.uid), slog.Any("error", cleanupErr))
+			err = errors.Join(err, cleanupErr)
+		}
+	}()
+
+	steps := []func() (*TaskInfo, error){
+		func() (*TaskInfo, error) {
+			return scratch.AddDocumentsWithContext(ctx, sample.Results, *primaryKey)
+		},
+		func() (*TaskInfo, error) {
+			return scratch.UpdateDocumentsByFunctionWithContext(ctx, &UpdateDocumentByFunctionRequest{
+				Function: req.Function,
+				Context:  req.Context,
+			})
+		},
+	}
+	if filterable != nil && len(*filterable) != 0 {
+		// the settings are applied before the documents are indexed
+		steps = append([]func() (*TaskInfo, error){
+			func() (*TaskInfo, error) {
+				return scratch.UpdateFilterableAttributesWithContext(ctx, filterable)
+			},
+		}, steps...)
+	}
+	for _, step := range steps {
+		info, err := step()
+		if err != nil {
+			return nil, err
+		}
+		task, err := scratch.WaitForTaskWithContext(ctx, info.TaskUID, 0)
+		if err != nil {
+			return nil, err
+		}
+		if task.Status != TaskStatusSucceeded {
+			return nil, fmt.Errorf("%w: task %d %s: %s", ErrEditDryRunFailed, task.UID, task.Status, task.Error.Message)
+		}
+	}
+
+	edited := new(DocumentsResult)
+	if err := scratch.GetDocumentsWithContext(ctx, &DocumentsQuery{Limit: sampleSize}, edited); err != nil {
+		return nil, err
+	}
+	result.Diffs = diffDocuments(*primaryKey, sample.Results, edited.Results)
+	return result, nil
+}
+
+// deleteScratch deletes the scratch index of a dry run and waits for the deletion
+func (i *index) deleteScratch(ctx context.Context) error {
+	info := new(TaskInfo)
+	err := i.client.executeRequest(ctx, &internalRequest{
+		endpoint:            "/indexes/" + i.uid,
+		method:              http.MethodDelete,
+		withResponse:        info,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "DryRunEditDocuments",
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %q: %w", ErrEditDryRunCleanup, i.uid, err)
+	}
+	task, err := i.WaitForTaskWithContext(ctx, info.TaskUID, 0)
+	if err != nil {
+		return fmt.Errorf("%w: %q: %w", ErrEditDryRunCleanup, i.uid, err)
+	}
+	if task.Status != TaskStatusSucceeded {
+		return fmt.Errorf("%w: %q: task %d %s: %s", ErrEditDryRunCleanup, i.uid, task.UID, task.Status, task.Error.Message)
+	}
+	return nil
+}
+
 func (i *index) GetDocument(identifier string, request *DocumentQuery, documentPtr interface{}) error {
 	return i.GetDocumentWithContext(context.Background(), identifier, request, documentPtr)
 }
@@ -274,6 +455,31 @@ func (i *index) GetDocuments(param *DocumentsQuery, resp *DocumentsResult) error
 }
 
 func (i *index) GetDocumentsWithContext(ctx context.Context, param *DocumentsQuery, resp *DocumentsResult) error {
+	req := i.documentsRequest(param, resp)
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return VersionErrorHintMessage(err, req)
+	}
+	return nil
+}
+
+func (i *index) GetDocumentsStream(param *DocumentsQuery, fn func(document json.RawMessage) error) (*DocumentsResult, error) {
+	return i.GetDocumentsStreamWithContext(context.Background(), param, fn)
+}
+
+func (i *index) GetDocumentsStreamWithContext(ctx context.Context, param *DocumentsQuery, fn func(document json.RawMessage) error) (*DocumentsResult, error) {
+	resp := new(DocumentsResult)
+	req := i.documentsRequest(param, nil)
+	if err := i.client.executeResultsStream(ctx, req, "results", resp, fn); err != nil {
+		if _, ok := err.(*Error); !ok {
+			// the errors of fn are returned as is
+			return nil, err
+		}
+		return nil, VersionErrorHintMessage(err, req)
+	}
+	return resp, nil
+}
+
+func (i *index) documentsRequest(param *DocumentsQuery, resp *DocumentsResult) *internalRequest {
 	req := &internalRequest{
 		endpoint:            "/indexes/" + i.uid + "/documents",
 		method:              http.MethodGet,
@@ -297,13 +503,11 @@ func (i *index) GetDocumentsWithContext(ctx context.Context, param *DocumentsQue
 		}
 	} else if param != nil && param.Filter != nil {
 		req.withRequest = param
+		req.experimentalFeature = filterFeature(param.Filter)
 		req.method = http.MethodPost
 		req.endpoint = req.endpoint + "/fetch"
 	}
-	if err := i.client.executeRequest(ctx, req); err != nil {
-		return VersionErrorHintMessage(err, req)
-	}
-	return nil
+	return req
 }
 
 func (i *index) DeleteDocument(identifier string) (*TaskInfo, error) {
@@ -363,6 +567,7 @@ func (i *index) DeleteDocumentsByFilterWithContext(ctx context.Context, filter i
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "DeleteDocumentsByFilter",
+		experimentalFeature: filterFeature(filter),
 	}
 	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, VersionErrorHintMessage(err, req)
@@ -411,6 +616,7 @@ func (i *index) addDocuments(ctx context.Context, documentsPtr interface{}, cont
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "AddDocuments",
+		streamRequest:       true,
 	}
 	if err = i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
@@ -535,6 +741,7 @@ func (i *index) updateDocuments(ctx context.Context, documentsPtr interface{}, c
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateDocuments",
+		streamRequest:       true,
 	}
 	if err = i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
@@ -88,6 +88,7 @@ type DocumentManager interface {
 	AddDocumentsCsvFromReaderInBatchesWithContext(ctx context.Context, documents io.Reader, batchSize int, options *CsvDocumentsQuery) ([]TaskInfo, error)
 
 	// AddDocumentsCsvFromReader adds documents from a CSV reader to the index.
+	// The reader is streamed, the request is retried only if the reader is an io.Seeker, eg. an *os.File.
 	AddDocumentsCsvFromReader(documents io.Reader, options *CsvDocumentsQuery) (*TaskInfo, error)
 
 	// AddDocumentsCsvFromReaderWithContext adds documents from a CSV reader to the index using the provided context for cancellation.
@@ -106,6 +107,7 @@ type DocumentManager interface {
 	AddDocumentsNdjsonInBatchesWithContext(ctx context.Context, documents []byte, batchSize int, primaryKey ...string) ([]TaskInfo, error)
 
 	// AddDocumentsNdjsonFromReader adds documents from a NDJSON reader to the index.
+	// The reader is streamed, the request is retried only if the reader is an io.Seeker, eg. an *os.File.
 	AddDocumentsNdjsonFromReader(documents io.Reader, primaryKey ...string) (*TaskInfo, error)
 
 	// AddDocumentsNdjsonFromReaderWithContext adds documents from a NDJSON reader to the index using the provided context for cancellation.
@@ -159,6 +161,35 @@ type DocumentManager interface {
 	// UpdateDocumentsByFunctionWithContext update documents by using function then provided context for cancellation.
 	UpdateDocumentsByFunctionWithContext(ctx context.Context, req *UpdateDocumentByFunctionRequest) (*TaskInfo, error)
 
+	// PatchDocuments applies the field-level operations of patch to the documents identified by identifiers.
+	// A patch sent as a function selects the documents with a filter on the primary key, which must be a filterable attribute.
+	PatchDocuments(patch *DocumentPatch, identifiers ...string) (*TaskInfo, error)
+
+	// PatchDocumentsWithContext applies the field-level operations of patch to the documents identified by identifiers using the provided context for cancellation.
+	PatchDocumentsWithContext(ctx context.Context, patch *DocumentPatch, identifiers ...string) (*TaskInfo, error)
+
+	// PatchDocumentsByFilter applies the field-level operations of patch to the documents matching filter.
+	PatchDocumentsByFilter(patch *DocumentPatch, filter string) (*TaskInfo, error)
+
+	// PatchDocumentsByFilterWithContext applies the field-level operations of patch to the documents matching filter using the provided context for cancellation.
+	PatchDocumentsByFilterWithContext(ctx context.Context, patch *DocumentPatch, filter string) (*TaskInfo, error)
+
+	// EditDocuments checks the editDocumentsByFunction experimental feature is enabled and updates documents with the function built by fn.
+	EditDocuments(fn *EditFunction) (*TaskInfo, error)
+
+	// EditDocumentsWithContext checks the editDocumentsByFunction experimental feature is enabled and updates documents with the function built by fn using the provided context for cancellation.
+	EditDocumentsWithContext(ctx context.Context, fn *EditFunction) (*TaskInfo, error)
+
+	// DryRunEditDocuments applies the function built by fn to a sample of sampleSize documents in a scratch index and reports the changes.
+	// The scratch index `<uid>-dry-run-<suffix>` is a real index created on the server with the primary key and the filterable
+	// attributes of the index, its other settings are not copied. The API key must be allowed to create and delete indexes.
+	// The scratch index is deleted afterward, an error wrapping ErrEditDryRunCleanup is returned when it could not be deleted.
+	DryRunEditDocuments(fn *EditFunction, sampleSize int64) (*EditDryRunResult, error)
+
+	// DryRunEditDocumentsWithContext applies the function built by fn to a sample of sampleSize documents in a scratch index and reports the changes using the provided context for cancellation.
+	// See DryRunEditDocuments for the scratch index.
+	DryRunEditDocumentsWithContext(ctx context.Context, fn *EditFunction, sampleSize int64) (*EditDryRunResult, error)
+
 	// DeleteDocument deletes a single document from the index by identifier.
 	DeleteDocument(identifier string) (*TaskInfo, error)
 
@@ -196,6 +227,14 @@ type DocumentReader interface {
 
 	// GetDocumentsWithContext retrieves multiple documents from the index using the provided context for cancellation.
 	GetDocumentsWithContext(ctx context.Context, param *DocumentsQuery, resp *DocumentsResult) error
+
+	// GetDocumentsStream retrieves multiple documents from the index and decodes them while the response is read,
+	// fn is called with every document instead of keeping them in the returned DocumentsResult.
+	GetDocumentsStream(param *DocumentsQuery, fn func(document json.RawMessage) error) (*DocumentsResult, error)
+
+	// GetDocumentsStreamWithContext retrieves multiple documents from the index and decodes them while the response
+	// is read using the provided context for cancellation, fn is called with every document.
+	GetDocumentsStreamWithContext(ctx context.Context, param *DocumentsQuery, fn func(document json.RawMessage) error) (*DocumentsResult, error)
 }
 
 type SearchReader interface {
@@ -205,6 +244,14 @@ type SearchReader interface {
 	// SearchWithContext performs a search query on the index using the provided context for cancellation.
 	SearchWithContext(ctx context.Context, query string, request *SearchRequest) (*SearchResponse, error)
 
+	// SearchStream performs a search query on the index and decodes the hits while the response is read,
+	// fn is called with every hit instead of keeping them in the returned SearchResponse.
+	SearchStream(query string, request *SearchRequest, fn func(hit json.RawMessage) error) (*SearchResponse, error)
+
+	// SearchStreamWithContext performs a search query on the index and decodes the hits while the response is read
+	// using the provided context for cancellation, fn is called with every hit.
+	SearchStreamWithContext(ctx context.Context, query string, request *SearchRequest, fn func(hit json.RawMessage) error) (*SearchResponse, error)
+
 	// SearchRaw performs a raw search query on the index, returning a JSON response.
 	SearchRaw(query string, request *SearchRequest) (*json.RawMessage, error)
 
@@ -212,10 +259,16 @@ type SearchReader interface {
 	SearchRawWithContext(ctx context.Context, query string, request *SearchRequest) (*json.RawMessage, error)
 
 	// FacetSearch performs a facet search query on the index.
-	FacetSearch(request *FacetSearchRequest) (*json.RawMessage, error)
+	FacetSearch(request *FacetSearchRequest) (*FacetSearchResponse, error)
 
 	// FacetSearchWithContext performs a facet search query on the index using the provided context for cancellation.
-	FacetSearchWithContext(ctx context.Context, request *FacetSearchRequest) (*json.RawMessage, error)
+	FacetSearchWithContext(ctx context.Context, request *FacetSearchRequest) (*FacetSearchResponse, error)
+
+	// FacetSearchRaw performs a raw facet search query on the index, returning a JSON response.
+	FacetSearchRaw(request *FacetSearchRequest) (*json.RawMessage, error)
+
+	// FacetSearchRawWithContext performs a raw facet search query on the index using the provided context for cancellation, returning a JSON response.
+	FacetSearchRawWithContext(ctx context.Context, request *FacetSearchRequest) (*json.RawMessage, error)
 
 	// SearchSimilarDocuments performs a search for similar documents.
 	SearchSimilarDocuments(param *SimilarDocumentQuery, resp *SimilarDocumentResult) error
@@ -0,0 +1,152 @@
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+)
+
+// IndexTemplate holds the configuration applied by CreateIndex to the indexes whose uid matches Pattern
+type IndexTemplate struct {
+	// Pattern is matched against the index uid with path.Match, eg. `logs-*`
+	Pattern string
+	// PrimaryKey is used when the IndexConfig given to CreateIndex has none
+	PrimaryKey string
+	// Settings are applied right after the index creation when not nil
+	Settings *Settings
+}
+
+// Match reports whether the template applies to the index uid
+func (t IndexTemplate) Match(uid string) (bool, error) {
+	ok, err := path.Match(t.Pattern, uid)
+	if err != nil {
+		return false, fmt.Errorf("%w: %q: %v", ErrInvalidIndexPattern, t.Pattern, err)
+	}
+	return ok, nil
+}
+
+// RetentionRule deletes the indexes whose uid matches Pattern once they are older than MaxAge
+type RetentionRule struct {
+	// Pattern is matched against the index uid with path.Match, eg. `logs-*`
+	Pattern string
+	// MaxAge is compared to the creation date of the index, eg. 30 * 24 * time.Hour for 30 days
+	MaxAge time.Duration
+}
+
+// indexTemplate returns the first registered template matching the index uid, nil if none does
+func (c *client) indexTemplate(uid string) (*IndexTemplate, error) {
+	for i := range c.indexTemplates {
+		ok, err := c.indexTemplates[i].Match(uid)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &c.indexTemplates[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *meilisearch) SetAlias(alias, uid string, deletePrevious bool) ([]*TaskInfo, error) {
+	return m.SetAliasWithContext(context.Background(), alias, uid, deletePrevious)
+}
+
+func (m *meilisearch) SetAliasWithContext(ctx context.Context, alias, uid string, deletePrevious bool) ([]*TaskInfo, error) {
+	if alias == "" || uid == "" || alias == uid {
+		return nil, fmt.Errorf("%w: cannot alias %q to %q", ErrInvalidAlias, alias, uid)
+	}
+
+	// the swap needs both indexes, the alias is created empty the first time
+	if _, err := m.GetIndexWithContext(ctx, alias); err != nil {
+		if !errors.Is(err, APIErrorCodeIndexNotFound) {
+			return nil, err
+		}
+		if _, err := m.createIndex(ctx, &IndexConfig{Uid: alias}); err != nil {
+			return nil, err
+		}
+	}
+
+	swap, err := m.SwapIndexesWithContext(ctx, []*SwapIndexesParams{{Indexes: []string{alias, uid}}})
+	if err != nil {
+		return nil, err
+	}
+	if !deletePrevious {
+		return []*TaskInfo{swap}, nil
+	}
+
+	// uid holds the previous content of alias only once the swap succeeded
+	if err := m.client.waitForSuccess(ctx, swap); err != nil {
+		return []*TaskInfo{swap}, err
+	}
+	deletion, err := m.DeleteIndexWithContext(ctx, uid)
+	if err != nil {
+		return []*TaskInfo{swap}, err
+	}
+	return []*TaskInfo{swap, deletion}, nil
+}
+
+// waitForSuccess waits for the task of info and returns an error when it did not succeed
+func (c *client) waitForSuccess(ctx context.Context, info *TaskInfo) error {
+	task, err := waitForTask(ctx, c, info.TaskUID, 0)
+	if err != nil {
+		return err
+	}
+	if task.Status == TaskStatusSucceeded {
+		return nil
+	}
+	if taskErr := task.Err(); taskErr != nil {
+		return fmt.Errorf("%w: task %d %s: %w", ErrTaskNotSucceeded, task.UID, task.Status, taskErr)
+	}
+	return fmt.Errorf("%w: task %d %s", ErrTaskNotSucceeded, task.UID, task.Status)
+}
+
+func (m *meilisearch) ApplyRetention(rules ...RetentionRule) ([]*TaskInfo, error) {
+	return m.ApplyRetentionWithContext(context.Background(), rules...)
+}
+
+func (m *meilisearch) ApplyRetentionWithContext(ctx context.Context, rules ...RetentionRule) ([]*TaskInfo, error) {
+	for _, rule := range rules {
+		if rule.MaxAge <= 0 {
+			return nil, fmt.Errorf("%w: %q must have a positive max age", ErrInvalidRetentionRule, rule.Pattern)
+		}
+		if _, err := path.Match(rule.Pattern, ""); err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidIndexPattern, rule.Pattern, err)
+		}
+	}
+
+	indexes, err := m.listAllIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tasks := make([]*TaskInfo, 0)
+	for _, idx := range indexes {
+		for _, rule := range rules {
+			if ok, _ := path.Match(rule.Pattern, idx.UID); !ok || now.Sub(idx.CreatedAt) <= rule.MaxAge {
+				continue
+			}
+			task, err := m.DeleteIndexWithContext(ctx, idx.UID)
+			if err != nil {
+				return tasks, err
+			}
+			tasks = append(tasks, task)
+			break
+		}
+	}
+	return tasks, nil
+}
+
+// listAllIndexes pages through ListIndexes until every index is fetched
+func (m *meilisearch) listAllIndexes(ctx context.Context) ([]*IndexResult, error) {
+	var indexes []*IndexResult
+	for idx, err := range m.AllIndexes(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, idx)
+	}
+	return indexes, nil
+}
@@ -0,0 +1,204 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexTemplates(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		requests = append(requests, fmt.Sprintf("%s %s %v", r.Method, r.URL.Path, body))
+		mu.Unlock()
+
+		if r.URL.Path == "/tasks/1" {
+			_, _ = w.Write([]byte(`{"uid":1,"status":"succeeded","type":"indexCreation"}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1,"status":"enqueued"}`))
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL,
+		WithIndexTemplates(IndexTemplate{Pattern: "logs-*", PrimaryKey: "id", Settings: &Settings{FilterableAttributes: []string{"level"}}}),
+		WithIndexTemplates(IndexTemplate{Pattern: "*", PrimaryKey: "uid"}),
+	)
+
+	_, err := client.CreateIndex(&IndexConfig{Uid: "logs-2024"})
+	require.NoError(t, err)
+	_, err = client.CreateIndex(&IndexConfig{Uid: "logs-2025", PrimaryKey: "key"})
+	require.NoError(t, err)
+	_, err = client.CreateIndex(&IndexConfig{Uid: "movies"})
+	require.NoError(t, err)
+
+	_, err = New(ts.URL).CreateIndex(&IndexConfig{Uid: "logs-2026"})
+	require.NoError(t, err)
+
+	_, err = New(ts.URL, WithIndexTemplates(IndexTemplate{Pattern: "["})).CreateIndex(&IndexConfig{Uid: "movies"})
+	require.ErrorIs(t, err, ErrInvalidIndexPattern)
+
+	require.Equal(t, []string{
+		"POST /indexes map[primaryKey:id uid:logs-2024]",
+		"GET /tasks/1 map[]",
+		"PATCH /indexes/logs-2024/settings map[filterableAttributes:[level]]",
+		"POST /indexes map[primaryKey:key uid:logs-2025]",
+		"GET /tasks/1 map[]",
+		"PATCH /indexes/logs-2025/settings map[filterableAttributes:[level]]",
+		"POST /indexes map[primaryKey:uid uid:movies]",
+		"POST /indexes map[uid:logs-2026]",
+	}, requests)
+}
+
+func TestIndexTemplates_IndexAlreadyExists(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/tasks/2" {
+			_, _ = w.Write([]byte(`{"uid":2,"status":"failed","type":"indexCreation",` +
+				`"error":{"message":"Index already exists.","code":"index_already_exists","type":"invalid_request"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":2,"status":"enqueued"}`))
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL,
+		WithIndexTemplates(IndexTemplate{Pattern: "logs-*", Settings: &Settings{FilterableAttributes: []string{"level"}}}),
+	)
+
+	task, err := client.CreateIndex(&IndexConfig{Uid: "logs-2023"})
+	require.Nil(t, task)
+	require.ErrorIs(t, err, ErrTaskNotSucceeded)
+	require.ErrorIs(t, err, APIErrorCodeIndexAlreadyExists)
+	require.Equal(t, []string{"POST /indexes", "GET /tasks/2"}, requests)
+}
+
+func TestSetAlias(t *testing.T) {
+	var requests []string
+	existing := map[string]bool{"products": true}
+	swapStatus := "succeeded"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.URL.Path == "/tasks/2":
+			_, _ = fmt.Fprintf(w, `{"uid":2,"status":%q,"type":"indexSwap"}`, swapStatus)
+		case r.Method == http.MethodGet:
+			uid := r.URL.Path[len("/indexes/"):]
+			if !existing[uid] {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"Index not found.","code":"index_not_found","type":"invalid_request","link":""}`))
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"uid":%q}`, uid)
+		case r.Method == http.MethodPost && r.URL.Path == "/swap-indexes":
+			var params []*SwapIndexesParams
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+			require.Len(t, params, 1)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":2,"type":"indexSwap"}`))
+		default:
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":3}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL)
+
+	tasks, err := client.SetAlias("products", "products-v2", false)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, TaskTypeIndexSwap, tasks[0].Type)
+	require.Equal(t, []string{"GET /indexes/products", "POST /swap-indexes"}, requests)
+
+	requests = nil
+	tasks, err = client.SetAlias("books", "books-v1", true)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	require.Equal(t, int64(2), tasks[0].TaskUID)
+	require.Equal(t, int64(3), tasks[1].TaskUID)
+	require.Equal(t, []string{
+		"GET /indexes/books", "POST /indexes", "POST /swap-indexes", "GET /tasks/2", "DELETE /indexes/books-v1",
+	}, requests)
+
+	// the previous index is kept when the swap fails
+	requests = nil
+	swapStatus = "failed"
+	tasks, err = client.SetAlias("products", "products-v3", true)
+	require.ErrorIs(t, err, ErrTaskNotSucceeded)
+	require.Len(t, tasks, 1)
+	require.Equal(t, []string{"GET /indexes/products", "POST /swap-indexes", "GET /tasks/2"}, requests)
+
+	_, err = client.SetAlias("books", "books", false)
+	require.ErrorIs(t, err, ErrInvalidAlias)
+}
+
+func TestApplyRetention(t *testing.T) {
+	now := time.Now()
+	var indexes []string
+	for i := 0; i < 250; i++ {
+		uid := fmt.Sprintf("logs-%03d", i)
+		if i%2 == 1 {
+			uid = fmt.Sprintf("events-%03d", i)
+		}
+		indexes = append(indexes, fmt.Sprintf(`{"uid":%q,"createdAt":%q}`, uid, now.Add(-time.Duration(i)*24*time.Hour).Format(time.RFC3339)))
+	}
+
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path[len("/indexes/"):])
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":1}`))
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := offset + limit
+		if end > len(indexes) {
+			end = len(indexes)
+		}
+		_, _ = fmt.Fprintf(w, `{"results":[%s],"offset":%d,"limit":%d,"total":%d}`, strings.Join(indexes[offset:end], ","), offset, limit, len(indexes))
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL)
+	tasks, err := client.ApplyRetention(
+		RetentionRule{Pattern: "logs-*", MaxAge: 200*24*time.Hour + time.Hour},
+		RetentionRule{Pattern: "events-24?", MaxAge: time.Hour},
+	)
+	require.NoError(t, err)
+
+	var expected []string
+	for i := 201; i < 250; i++ {
+		if i%2 == 0 {
+			expected = append(expected, fmt.Sprintf("logs-%03d", i))
+		} else if i >= 241 {
+			expected = append(expected, fmt.Sprintf("events-%03d", i))
+		}
+	}
+	require.Equal(t, expected, deleted)
+	require.Len(t, tasks, len(expected))
+
+	_, err = client.ApplyRetention(RetentionRule{Pattern: "logs-*"})
+	require.ErrorIs(t, err, ErrInvalidRetentionRule)
+	_, err = client.ApplyRetention(RetentionRule{Pattern: "[", MaxAge: time.Hour})
+	require.ErrorIs(t, err, ErrInvalidIndexPattern)
+}
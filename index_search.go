@@ -11,6 +11,38 @@ func (i *index) Search(query string, request *SearchRequest) (*SearchResponse, e
 }
 
 func (i *index) SearchWithContext(ctx context.Context, query string, request *SearchRequest) (*SearchResponse, error) {
+	resp := new(SearchResponse)
+	req, err := i.searchRequest(query, request, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (i *index) SearchStream(query string, request *SearchRequest, fn func(hit json.RawMessage) error) (*SearchResponse, error) {
+	return i.SearchStreamWithContext(context.Background(), query, request, fn)
+}
+
+func (i *index) SearchStreamWithContext(ctx context.Context, query string, request *SearchRequest, fn func(hit json.RawMessage) error) (*SearchResponse, error) {
+	resp := new(SearchResponse)
+	req, err := i.searchRequest(query, request, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.client.executeResultsStream(ctx, req, "hits", resp, fn); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (i *index) searchRequest(query string, request *SearchRequest, resp *SearchResponse) (*internalRequest, error) {
 	if request == nil {
 		return nil, ErrNoSearchRequest
 	}
@@ -25,9 +57,7 @@ func (i *index) SearchWithContext(ctx context.Context, query string, request *Se
 
 	request.validate()
 
-	resp := new(SearchResponse)
-
-	req := &internalRequest{
+	return &internalRequest{
 		endpoint:            "/indexes/" + i.uid + "/search",
 		method:              http.MethodPost,
 		contentType:         contentTypeJSON,
@@ -35,13 +65,8 @@ func (i *index) SearchWithContext(ctx context.Context, query string, request *Se
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "Search",
-	}
-
-	if err := i.client.executeRequest(ctx, req); err != nil {
-		return nil, err
-	}
-
-	return resp, nil
+		experimentalFeature: filterFeature(request.Filter),
+	}, nil
 }
 
 func (i *index) SearchRaw(query string, request *SearchRequest) (*json.RawMessage, error) {
@@ -73,6 +98,7 @@ func (i *index) SearchRawWithContext(ctx context.Context, query string, request
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "SearchRaw",
+		experimentalFeature: filterFeature(request.Filter),
 	}
 
 	if err := i.client.executeRequest(ctx, req); err != nil {
@@ -82,16 +108,16 @@ func (i *index) SearchRawWithContext(ctx context.Context, query string, request
 	return resp, nil
 }
 
-func (i *index) FacetSearch(request *FacetSearchRequest) (*json.RawMessage, error) {
+func (i *index) FacetSearch(request *FacetSearchRequest) (*FacetSearchResponse, error) {
 	return i.FacetSearchWithContext(context.Background(), request)
 }
 
-func (i *index) FacetSearchWithContext(ctx context.Context, request *FacetSearchRequest) (*json.RawMessage, error) {
+func (i *index) FacetSearchWithContext(ctx context.Context, request *FacetSearchRequest) (*FacetSearchResponse, error) {
 	if request == nil {
 		return nil, ErrNoFacetSearchRequest
 	}
 
-	resp := new(json.RawMessage)
+	resp := new(FacetSearchResponse)
 
 	req := &internalRequest{
 		endpoint:            "/indexes/" + i.uid + "/facet-search",
@@ -101,6 +127,36 @@ func (i *index) FacetSearchWithContext(ctx context.Context, request *FacetSearch
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "FacetSearch",
+		experimentalFeature: filterFeature(request.Filter),
+	}
+
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (i *index) FacetSearchRaw(request *FacetSearchRequest) (*json.RawMessage, error) {
+	return i.FacetSearchRawWithContext(context.Background(), request)
+}
+
+func (i *index) FacetSearchRawWithContext(ctx context.Context, request *FacetSearchRequest) (*json.RawMessage, error) {
+	if request == nil {
+		return nil, ErrNoFacetSearchRequest
+	}
+
+	resp := new(json.RawMessage)
+
+	req := &internalRequest{
+		endpoint:            "/indexes/" + i.uid + "/facet-search",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "FacetSearchRaw",
+		experimentalFeature: filterFeature(request.Filter),
 	}
 
 	if err := i.client.executeRequest(ctx, req); err != nil {
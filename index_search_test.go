@@ -29,10 +29,8 @@ func TestIndex_SearchWithContentEncoding(t *testing.T) {
 				FacetQuery: "Novel",
 			},
 			FacetResponse: &FacetSearchResponse{
-				FacetHits: []interface{}{
-					map[string]interface{}{
-						"value": "Novel", "count": float64(5),
-					},
+				FacetHits: []FacetHit{
+					{Value: "Novel", Count: 5},
 				},
 				FacetQuery: "Novel",
 			},
@@ -75,10 +73,8 @@ func TestIndex_SearchWithContentEncoding(t *testing.T) {
 				FacetQuery: "Novel",
 			},
 			FacetResponse: &FacetSearchResponse{
-				FacetHits: []interface{}{
-					map[string]interface{}{
-						"value": "Novel", "count": float64(5),
-					},
+				FacetHits: []FacetHit{
+					{Value: "Novel", Count: 5},
 				},
 				FacetQuery: "Novel",
 			},
@@ -108,10 +104,8 @@ func TestIndex_SearchWithContentEncoding(t *testing.T) {
 				FacetQuery: "Novel",
 			},
 			FacetResponse: &FacetSearchResponse{
-				FacetHits: []interface{}{
-					map[string]interface{}{
-						"value": "Novel", "count": float64(5),
-					},
+				FacetHits: []FacetHit{
+					{Value: "Novel", Count: 5},
 				},
 				FacetQuery: "Novel",
 			},
@@ -141,7 +135,7 @@ func TestIndex_SearchWithContentEncoding(t *testing.T) {
 			require.NoError(t, err)
 			testWaitForTask(t, i, task)
 
-			gotJson, err = i.FacetSearch(tt.FacetRequest)
+			gotJson, err = i.FacetSearchRaw(tt.FacetRequest)
 			require.NoError(t, err)
 			var gotFacet FacetSearchResponse
 			err = json.Unmarshal(*gotJson, &gotFacet)
@@ -844,10 +838,10 @@ func TestIndex_SearchFacets(t *testing.T) {
 				EstimatedTotalHits: 2,
 				Offset:             0,
 				Limit:              20,
-				FacetDistribution: map[string]interface{}{
-					"tag": map[string]interface{}{
-						"Epic fantasy": float64(1),
-						"Tale":         float64(1),
+				FacetDistribution: FacetDistribution{
+					"tag": {
+						"Epic fantasy": 1,
+						"Tale":         1,
 					},
 				},
 			},
@@ -876,10 +870,10 @@ func TestIndex_SearchFacets(t *testing.T) {
 				EstimatedTotalHits: 2,
 				Offset:             0,
 				Limit:              20,
-				FacetDistribution: map[string]interface{}{
-					"tag": map[string]interface{}{
-						"Epic fantasy": float64(1),
-						"Tale":         float64(1),
+				FacetDistribution: FacetDistribution{
+					"tag": {
+						"Epic fantasy": 1,
+						"Tale":         1,
 					},
 				},
 			},
@@ -908,16 +902,16 @@ func TestIndex_SearchFacets(t *testing.T) {
 				EstimatedTotalHits: 2,
 				Offset:             0,
 				Limit:              20,
-				FacetDistribution: map[string]interface{}{
-					"book_id": map[string]interface{}{
-						"4":   float64(1),
-						"456": float64(1),
+				FacetDistribution: FacetDistribution{
+					"book_id": {
+						"4":   1,
+						"456": 1,
 					},
 				},
-				FacetStats: map[string]interface{}{
-					"book_id": map[string]interface{}{
-						"max": float64(456),
-						"min": float64(4),
+				FacetStats: FacetStats{
+					"book_id": {
+						Max: 456,
+						Min: 4,
 					},
 				},
 			},
@@ -2080,10 +2074,8 @@ func TestIndex_FacetSearch(t *testing.T) {
 				filterableAttributes: []string{"tag"},
 			},
 			want: &FacetSearchResponse{
-				FacetHits: []interface{}{
-					map[string]interface{}{
-						"value": "Novel", "count": float64(5),
-					},
+				FacetHits: []FacetHit{
+					{Value: "Novel", Count: 5},
 				},
 				FacetQuery: "Novel",
 			},
@@ -2102,10 +2094,8 @@ func TestIndex_FacetSearch(t *testing.T) {
 				filterableAttributes: []string{"tag"},
 			},
 			want: &FacetSearchResponse{
-				FacetHits: []interface{}{
-					map[string]interface{}{
-						"value": "Novel", "count": float64(5),
-					},
+				FacetHits: []FacetHit{
+					{Value: "Novel", Count: 5},
 				},
 				FacetQuery: "Novel",
 			},
@@ -2124,10 +2114,8 @@ func TestIndex_FacetSearch(t *testing.T) {
 				filterableAttributes: []string{"tag"},
 			},
 			want: &FacetSearchResponse{
-				FacetHits: []interface{}{
-					map[string]interface{}{
-						"value": "Novel", "count": float64(5),
-					},
+				FacetHits: []FacetHit{
+					{Value: "Novel", Count: 5},
 				},
 				FacetQuery: "Novel",
 			},
@@ -2146,10 +2134,8 @@ func TestIndex_FacetSearch(t *testing.T) {
 				filterableAttributes: []string{"tag"},
 			},
 			want: &FacetSearchResponse{
-				FacetHits: []interface{}{
-					map[string]interface{}{
-						"value": "Novel", "count": float64(5),
-					},
+				FacetHits: []FacetHit{
+					{Value: "Novel", Count: 5},
 				},
 				FacetQuery: "Novel",
 			},
@@ -2214,7 +2200,7 @@ func TestIndex_FacetSearch(t *testing.T) {
 				filterableAttributes: []string{"tag"},
 			},
 			want: &FacetSearchResponse{
-				FacetHits:  []interface{}{},
+				FacetHits:  []FacetHit{},
 				FacetQuery: "",
 			},
 			wantErr: false,
@@ -2234,26 +2220,25 @@ func TestIndex_FacetSearch(t *testing.T) {
 				testWaitForTask(t, i, updateFilter)
 			}
 
-			gotRaw, err := i.FacetSearch(tt.args.request)
+			got, err := i.FacetSearch(tt.args.request)
 
 			if tt.wantErr {
 				require.Error(t, err)
-				require.Nil(t, gotRaw)
+				require.Nil(t, got)
 				return
 			}
 
 			require.NoError(t, err)
-			// Unmarshall the raw response from FacetSearch into a FacetSearchResponse
-			var got FacetSearchResponse
-			err = json.Unmarshal(*gotRaw, &got)
-			require.NoError(t, err, "error unmarshalling raw got FacetSearchResponse")
-
-			require.Equal(t, len(tt.want.FacetHits), len(got.FacetHits))
-			for len := range got.FacetHits {
-				require.Equal(t, tt.want.FacetHits[len].(map[string]interface{})["value"], got.FacetHits[len].(map[string]interface{})["value"])
-				require.Equal(t, tt.want.FacetHits[len].(map[string]interface{})["count"], got.FacetHits[len].(map[string]interface{})["count"])
-			}
+			require.Equal(t, tt.want.FacetHits, got.FacetHits)
 			require.Equal(t, tt.want.FacetQuery, got.FacetQuery)
+
+			gotRaw, err := i.FacetSearchRaw(tt.args.request)
+			require.NoError(t, err)
+			// Unmarshall the raw response from FacetSearchRaw into a FacetSearchResponse
+			var gotFromRaw FacetSearchResponse
+			err = json.Unmarshal(*gotRaw, &gotFromRaw)
+			require.NoError(t, err, "error unmarshalling raw got FacetSearchResponse")
+			require.Equal(t, got.FacetHits, gotFromRaw.FacetHits)
 		})
 	}
 }
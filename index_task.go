@@ -2,6 +2,7 @@ package meilisearch
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"strconv"
 	"strings"
@@ -70,6 +71,40 @@ func (i *index) GetTasksWithContext(ctx context.Context, param *TasksQuery) (*Ta
 	return resp, nil
 }
 
+func (i *index) AllTasks(ctx context.Context, param *TasksQuery) iter.Seq2[Task, error] {
+	query := TasksQuery{}
+	if param != nil {
+		query = *param
+	}
+	query.IndexUIDS = []string{i.uid}
+	return allTasks(ctx, i.client, query)
+}
+
+func (i *index) GetBatch(batchUID int64) (*Batch, error) {
+	return i.GetBatchWithContext(context.Background(), batchUID)
+}
+
+func (i *index) GetBatchWithContext(ctx context.Context, batchUID int64) (*Batch, error) {
+	return getBatch(ctx, i.client, batchUID)
+}
+
+func (i *index) GetBatches(param *BatchesQuery) (*BatchesResults, error) {
+	return i.GetBatchesWithContext(context.Background(), param)
+}
+
+func (i *index) GetBatchesWithContext(ctx context.Context, param *BatchesQuery) (*BatchesResults, error) {
+	query := BatchesQuery{}
+	if param != nil {
+		query = *param
+	}
+	query.IndexUIDS = []string{i.uid}
+	return getBatches(ctx, i.client, &query)
+}
+
+func (i *index) WaitForTaskWithProgress(ctx context.Context, taskUID int64, interval time.Duration, fn func(TaskProgress)) (*Task, error) {
+	return waitForTaskWithProgress(ctx, i.client, taskUID, interval, fn)
+}
+
 func (i *index) WaitForTask(taskUID int64, interval time.Duration) (*Task, error) {
 	return waitForTask(context.Background(), i.client, taskUID, interval)
 }
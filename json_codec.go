@@ -0,0 +1,91 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/mailru/easyjson"
+)
+
+// JSONCodec marshals the bodies of the requests and unmarshals the bodies of the responses,
+// see WithJSONCodec
+type JSONCodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+var (
+	// StdJSONCodec uses encoding/json for every value
+	StdJSONCodec JSONCodec = stdJSONCodec{}
+
+	// EasyJSONCodec uses the code generated by easyjson for the types of this package and encoding/json
+	// for the other values, it is the default codec
+	EasyJSONCodec JSONCodec = easyJSONCodec{}
+)
+
+// NewJSONCodec returns a JSONCodec calling marshal and unmarshal, eg. with github.com/bytedance/sonic
+// or github.com/goccy/go-json:
+//
+//	meilisearch.New(host, meilisearch.WithJSONCodec(meilisearch.NewJSONCodec(sonic.Marshal, sonic.Unmarshal)))
+func NewJSONCodec(marshal func(v interface{}) ([]byte, error), unmarshal func(data []byte, v interface{}) error) JSONCodec {
+	return funcJSONCodec{marshal: marshal, unmarshal: unmarshal}
+}
+
+type stdJSONCodec struct{}
+
+func (stdJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+type easyJSONCodec struct{}
+
+func (easyJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	switch m := v.(type) {
+	case easyjson.Marshaler:
+		return easyjson.Marshal(m)
+	case json.Marshaler:
+		data, err := m.MarshalJSON()
+		if err == nil && data == nil {
+			return nil, errors.New("MarshalJSON returned nil data")
+		}
+		return data, err
+	default:
+		return json.Marshal(v)
+	}
+}
+
+func (easyJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	switch u := v.(type) {
+	case easyjson.Unmarshaler:
+		return easyjson.Unmarshal(data, u)
+	case json.Unmarshaler:
+		return u.UnmarshalJSON(data)
+	default:
+		return json.Unmarshal(data, v)
+	}
+}
+
+type funcJSONCodec struct {
+	marshal   func(v interface{}) ([]byte, error)
+	unmarshal func(data []byte, v interface{}) error
+}
+
+func (c funcJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return c.marshal(v)
+}
+
+func (c funcJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return c.unmarshal(data, v)
+}
+
+// codecOrDefault returns the default codec when codec is nil
+func codecOrDefault(codec JSONCodec) JSONCodec {
+	if codec == nil {
+		return EasyJSONCodec
+	}
+	return codec
+}
@@ -0,0 +1,146 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nilMarshaler struct{}
+
+func (nilMarshaler) MarshalJSON() ([]byte, error) {
+	return nil, nil
+}
+
+func TestJSONCodecs(t *testing.T) {
+	for _, codec := range []JSONCodec{StdJSONCodec, EasyJSONCodec, NewJSONCodec(json.Marshal, json.Unmarshal)} {
+		data, err := codec.Marshal(&SearchRequest{Limit: 10, Filter: "genre = drama"})
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"limit":10`)
+		require.Contains(t, string(data), `"filter":"genre = drama"`)
+
+		data, err = codec.Marshal(map[string]interface{}{"id": 1})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id":1}`, string(data))
+
+		resp := new(SearchResponse)
+		require.NoError(t, codec.Unmarshal([]byte(`{"hits":[{"id":1}],"query":"carol","estimatedTotalHits":1}`), resp))
+		require.Equal(t, "carol", resp.Query)
+		require.Equal(t, int64(1), resp.EstimatedTotalHits)
+		require.Len(t, resp.Hits, 1)
+
+		var ranking RankingScoreDetails
+		require.Error(t, codec.Unmarshal([]byte(`{`), &ranking))
+
+		_, err = codec.Marshal(make(chan int))
+		require.Error(t, err)
+	}
+
+	_, err := EasyJSONCodec.Marshal(nilMarshaler{})
+	require.EqualError(t, err, "MarshalJSON returned nil data")
+}
+
+// countingCodec records the types it marshals and unmarshals
+type countingCodec struct {
+	mu          sync.Mutex
+	marshaled   []string
+	unmarshaled []string
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	c.mu.Lock()
+	c.marshaled = append(c.marshaled, typeName(v))
+	c.mu.Unlock()
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	c.mu.Lock()
+	c.unmarshaled = append(c.unmarshaled, typeName(v))
+	c.mu.Unlock()
+	return json.Unmarshal(data, v)
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case *SearchRequest:
+		return "SearchRequest"
+	case *SearchResponse:
+		return "SearchResponse"
+	case *TaskInfo:
+		return "TaskInfo"
+	case *APIError:
+		return "APIError"
+	case map[string]interface{}:
+		return "document"
+	case *json.RawMessage:
+		return "raw"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func TestWithJSONCodec(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/search":
+			_, _ = w.Write([]byte(`{"hits":[{"id":1}],"query":"carol"}`))
+		case "/indexes/movies/documents":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":1,"status":"enqueued"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index not found.","code":"index_not_found","type":"invalid_request","link":""}`))
+		}
+	}))
+	defer ts.Close()
+
+	codec := &countingCodec{}
+	sm := New(ts.URL, WithJSONCodec(codec))
+
+	_, err := sm.Index("movies").Search("carol", &SearchRequest{})
+	require.NoError(t, err)
+	_, err = sm.Index("movies").AddDocuments([]map[string]interface{}{{"id": 1}, {"id": 2}})
+	require.NoError(t, err)
+	_, err = sm.Index("unknown").Search("carol", &SearchRequest{})
+	require.True(t, errors.Is(err, APIErrorCodeIndexNotFound))
+
+	require.Equal(t, []string{"SearchRequest", "document", "document", "SearchRequest"}, codec.marshaled)
+	require.Equal(t, []string{"SearchResponse", "TaskInfo", "APIError"}, codec.unmarshaled)
+}
+
+func TestWithJSONCodec_TypedIndex(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/books/search":
+			_, _ = w.Write([]byte(`{"hits":[{"isbn":"3","title":"Life of Pi"}],"query":"pi"}`))
+		case "/indexes/books/documents":
+			_, _ = w.Write([]byte(`{"results":[{"isbn":"1","title":"Carol"}],"offset":0,"limit":20,"total":1}`))
+		}
+	}))
+	defer ts.Close()
+
+	codec := &countingCodec{}
+	books, err := NewTypedIndex[typedTestBook](New(ts.URL, WithJSONCodec(codec)).Index("books"))
+	require.NoError(t, err)
+
+	hits, err := books.Search("pi", nil)
+	require.NoError(t, err)
+	require.Equal(t, []typedTestBook{{ISBN: "3", Title: "Life of Pi"}}, hits)
+	require.Len(t, codec.unmarshaled, 2)
+	require.Equal(t, "raw", codec.unmarshaled[0])
+	require.Contains(t, codec.unmarshaled[1], "typedTestBook")
+
+	codec.unmarshaled = nil
+	for _, err := range books.DocumentsWithQuery(context.Background(), nil) {
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"*meilisearch.DocumentsResult", "*meilisearch.typedTestBook"}, codec.unmarshaled)
+}
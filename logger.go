@@ -0,0 +1,52 @@
+package meilisearch
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// logEnabled reports whether the client logs the records of the level
+func (c *client) logEnabled(ctx context.Context, level slog.Level) bool {
+	return c.logger != nil && c.logger.Enabled(ctx, level)
+}
+
+func (c *client) log(ctx context.Context, level slog.Level, msg string, e *Error, attrs ...slog.Attr) {
+	if !c.logEnabled(ctx, level) {
+		return
+	}
+	attrs = append([]slog.Attr{
+		slog.String("function", e.Function),
+		slog.String("method", e.Method),
+		slog.String("endpoint", e.Endpoint),
+	}, attrs...)
+	c.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// logBody logs a request or response body when the body logging is enabled, the secrets are
+// redacted and the body capture of the errors is applied
+func (c *client) logBody(ctx context.Context, msg string, e *Error, body []byte) {
+	if !c.logBodies || !c.logEnabled(ctx, slog.LevelDebug) {
+		return
+	}
+	capture := BodyCapture{}
+	if c.bodyCapture != nil {
+		capture = *c.bodyCapture
+	}
+	if capture.Redact == nil {
+		capture.Redact = RedactSecrets
+	}
+	c.log(ctx, slog.LevelDebug, msg, e, slog.String("body", capture.capture(e.Endpoint, body)))
+}
+
+// logTaskWait logs the status of a task polled while waiting for it
+func (c *client) logTaskWait(ctx context.Context, task *Task, elapsed time.Duration) {
+	if !c.logEnabled(ctx, slog.LevelDebug) {
+		return
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "waiting for meilisearch task",
+		slog.Int64("taskUid", task.UID),
+		slog.String("status", string(task.Status)),
+		slog.String("type", string(task.Type)),
+		slog.Duration("elapsed", elapsed))
+}
@@ -0,0 +1,103 @@
+package meilisearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLogs(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var records []map[string]interface{}
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		record := map[string]interface{}{}
+		require.NoError(t, dec.Decode(&record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestWithLogger(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/settings/embedders":
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":1,"indexUid":"movies","status":"enqueued","type":"settingsUpdate"}`))
+		case "/tasks/1":
+			_, _ = w.Write([]byte(`{"uid":1,"indexUid":"movies","status":"succeeded","type":"settingsUpdate"}`))
+		}
+	}))
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	sm := New(ts.URL, WithLogger(logger), WithBodyLogging())
+	sm.(*meilisearch).client.retryBackoff = func(uint8) time.Duration { return time.Millisecond }
+
+	info, err := sm.Index("movies").UpdateEmbedders(map[string]Embedder{"default": {Source: "openAi", APIKey: "sk-secret"}})
+	require.NoError(t, err)
+	_, err = sm.WaitForTask(info.TaskUID, 0)
+	require.NoError(t, err)
+
+	records := decodeLogs(t, buf)
+	var messages []string
+	for _, record := range records {
+		messages = append(messages, record["msg"].(string))
+	}
+	require.Equal(t, []string{
+		"meilisearch request body",
+		"meilisearch request",
+		"retrying meilisearch request",
+		"meilisearch request finished",
+		"meilisearch response body",
+		"meilisearch request",
+		"meilisearch request finished",
+		"meilisearch response body",
+		"waiting for meilisearch task",
+	}, messages)
+
+	require.Equal(t, `{"default":{"source":"openAi","apiKey":"[REDACTED]"}}`, records[0]["body"])
+	require.Equal(t, "UpdateEmbedders", records[0]["function"])
+	require.Equal(t, "/indexes/movies/settings/embedders", records[0]["endpoint"])
+	require.Equal(t, "WARN", records[2]["level"])
+	require.Equal(t, float64(1), records[2]["attempt"])
+	require.Equal(t, float64(503), records[2]["status"])
+	require.Equal(t, float64(202), records[3]["status"])
+	require.Equal(t, float64(1), records[3]["retries"])
+	require.Contains(t, records[3], "duration")
+	require.Equal(t, "succeeded", records[8]["status"])
+	require.NotContains(t, buf.String(), "sk-secret")
+}
+
+func TestWithLogger_WarnLevel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	sm := New(ts.URL, WithLogger(logger), WithCustomRetries([]int{http.StatusBadGateway}, 1))
+	sm.(*meilisearch).client.retryBackoff = func(uint8) time.Duration { return time.Millisecond }
+
+	_, err := sm.VersionWithContext(context.Background())
+	require.Error(t, err)
+
+	records := decodeLogs(t, buf)
+	require.Len(t, records, 2)
+	require.Equal(t, "retrying meilisearch request", records[0]["msg"])
+	require.Equal(t, "meilisearch request max retries exceeded", records[1]["msg"])
+}
@@ -0,0 +1,211 @@
+package meilisearch
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogMode is the format of the logs sent by /logs/stream
+type LogMode string
+
+const (
+	// LogModeHuman streams the logs as human-readable lines
+	LogModeHuman LogMode = "human"
+	// LogModeJSON streams one JSON object per log
+	LogModeJSON LogMode = "json"
+	// LogModeProfile streams the trace events used to profile the engine, eg. with the Firefox profiler
+	LogModeProfile LogMode = "profile"
+)
+
+// LogStreamOptions configures the logs sent by /logs/stream
+type LogStreamOptions struct {
+	// Target selects the logs with the tracing filter syntax, eg. `milli=trace,index_scheduler=info`
+	Target string `json:"target"`
+	// Mode is the format of the logs, LogModeHuman when empty
+	Mode LogMode `json:"mode,omitempty"`
+}
+
+// LogEvent is a log sent by /logs/stream. Raw is always set, the other fields are decoded from it
+// when the line follows the format of its mode.
+type LogEvent struct {
+	Raw       string
+	Timestamp time.Time
+	Level     string
+	Target    string
+	Message   string
+	// Fields are the fields of a JSON log, or the trace event of a profile log
+	Fields map[string]interface{}
+	// Spans are the spans a JSON log belongs to
+	Spans []map[string]interface{}
+}
+
+// LogStream receives the logs of a StreamLogs call. Events is closed when the stream ends, Err
+// then returns the error that ended it.
+type LogStream struct {
+	Events <-chan LogEvent
+
+	client *client
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+	err    error
+}
+
+// Err returns the error which ended the stream, nil when it was closed or its context cancelled
+func (s *LogStream) Err() error {
+	<-s.done
+	return s.err
+}
+
+// Close stops the stream and asks meilisearch to release it, another stream can then be opened
+func (s *LogStream) Close() error {
+	var err error
+	s.once.Do(func() {
+		s.cancel()
+		<-s.done
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		err = s.client.executeRequest(ctx, &internalRequest{
+			endpoint:            "/logs/stream",
+			method:              http.MethodDelete,
+			acceptedStatusCodes: []int{http.StatusNoContent},
+			functionName:        "StopLogStream",
+		})
+	})
+	return err
+}
+
+// StreamLogs opens a stream of the engine logs, the logsRoute experimental feature must be enabled.
+// The events are sent on the returned stream until ctx is cancelled or Close is called, Close must
+// always be called to release the stream on the meilisearch side, only one stream can be opened at
+// a time. The http.Client of the client must not have a Timeout.
+//
+// Documentation: https://www.meilisearch.com/docs/reference/api/logs
+func (m *meilisearch) StreamLogs(ctx context.Context, options *LogStreamOptions) (*LogStream, error) {
+	// the defaults are filled in a copy, the options of the caller are left untouched
+	opts := LogStreamOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.Mode == "" {
+		opts.Mode = LogModeHuman
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	req := &internalRequest{
+		endpoint:            "/logs/stream",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         &opts,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "StreamLogs",
+		experimentalFeature: ExperimentalFeatureLogsRoute,
+	}
+	resp, err := m.client.executeStreamRequest(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	events := make(chan LogEvent, 64)
+	stream := &LogStream{
+		Events: events,
+		client: m.client,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(stream.done)
+		defer close(events)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			select {
+			case events <- parseLogEvent(opts.Mode, line, m.client.jsonCodec):
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil && ctx.Err() == nil {
+			stream.err = err
+		}
+	}()
+
+	return stream, nil
+}
+
+func (m *meilisearch) UpdateStderrLogs(target string) error {
+	return m.UpdateStderrLogsWithContext(context.Background(), target)
+}
+
+func (m *meilisearch) UpdateStderrLogsWithContext(ctx context.Context, target string) error {
+	req := &internalRequest{
+		endpoint:            "/logs/stderr",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         map[string]string{"target": target},
+		acceptedStatusCodes: []int{http.StatusNoContent},
+		functionName:        "UpdateStderrLogs",
+		experimentalFeature: ExperimentalFeatureLogsRoute,
+	}
+	return m.client.executeRequest(ctx, req)
+}
+
+var (
+	ansiEscapeRegexp = regexp.MustCompile("\x1b\\[[0-9;]*m")
+	humanLogRegexp   = regexp.MustCompile(`^(\S+)\s+(TRACE|DEBUG|INFO|WARN|ERROR)\s+(.*?): (.*)$`)
+)
+
+func parseLogEvent(mode LogMode, line string, codec JSONCodec) LogEvent {
+	event := LogEvent{Raw: line}
+
+	switch mode {
+	case LogModeJSON:
+		var log struct {
+			Timestamp time.Time                `json:"timestamp"`
+			Level     string                   `json:"level"`
+			Target    string                   `json:"target"`
+			Fields    map[string]interface{}   `json:"fields"`
+			Spans     []map[string]interface{} `json:"spans"`
+		}
+		if err := codec.Unmarshal([]byte(line), &log); err != nil {
+			return event
+		}
+		event.Timestamp = log.Timestamp
+		event.Level = log.Level
+		event.Target = log.Target
+		event.Fields = log.Fields
+		event.Spans = log.Spans
+		if msg, ok := log.Fields["message"].(string); ok {
+			event.Message = msg
+		}
+	case LogModeProfile:
+		_ = codec.Unmarshal([]byte(line), &event.Fields)
+	default:
+		match := humanLogRegexp.FindStringSubmatch(ansiEscapeRegexp.ReplaceAllString(line, ""))
+		if match == nil {
+			event.Message = line
+			return event
+		}
+		event.Timestamp, _ = time.Parse(time.RFC3339Nano, match[1])
+		event.Level = match[2]
+		event.Target = match[3]
+		event.Message = match[4]
+	}
+	return event
+}
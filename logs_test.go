@@ -0,0 +1,118 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogEvent(t *testing.T) {
+	e := parseLogEvent(LogModeHuman, "2024-02-06T14:54:11.919622Z  INFO \x1b[2mactix_server::builder\x1b[0m: starting 10 workers: ok", EasyJSONCodec)
+	require.Equal(t, time.Date(2024, 2, 6, 14, 54, 11, 919622000, time.UTC), e.Timestamp)
+	require.Equal(t, "INFO", e.Level)
+	require.Equal(t, "actix_server::builder", e.Target)
+	require.Equal(t, "starting 10 workers: ok", e.Message)
+
+	e = parseLogEvent(LogModeHuman, "  at src/main.rs:10", EasyJSONCodec)
+	require.Equal(t, "  at src/main.rs:10", e.Message)
+	require.Empty(t, e.Level)
+
+	e = parseLogEvent(LogModeJSON, `{"timestamp":"2024-02-06T14:54:11.919622Z","level":"DEBUG","fields":{"message":"indexing","docs":3},`+
+		`"target":"milli::update","spans":[{"name":"batch","id":1}]}`, EasyJSONCodec)
+	require.Equal(t, "DEBUG", e.Level)
+	require.Equal(t, "milli::update", e.Target)
+	require.Equal(t, "indexing", e.Message)
+	require.Equal(t, float64(3), e.Fields["docs"])
+	require.Equal(t, []map[string]interface{}{{"name": "batch", "id": float64(1)}}, e.Spans)
+
+	e = parseLogEvent(LogModeProfile, `{"Enter":{"span_id":1,"time":{"secs":1,"nanos":0}}}`, EasyJSONCodec)
+	require.Contains(t, e.Fields, "Enter")
+	require.Equal(t, `{"Enter":{"span_id":1,"time":{"secs":1,"nanos":0}}}`, e.Raw)
+}
+
+func TestStreamLogs(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/logs/stream":
+			var opts LogStreamOptions
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&opts))
+			require.Equal(t, LogStreamOptions{Target: "milli=trace", Mode: LogModeJSON}, opts)
+
+			for i := 0; i < 3; i++ {
+				_, _ = fmt.Fprintf(w, `{"level":"INFO","target":"milli","fields":{"message":"log %d"}}`+"\n\n", i)
+				w.(http.Flusher).Flush()
+			}
+			<-r.Context().Done()
+		case r.URL.Path == "/logs/stream" || r.URL.Path == "/logs/stderr":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL)
+	stream, err := client.StreamLogs(context.Background(), &LogStreamOptions{Target: "milli=trace", Mode: LogModeJSON})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		e := <-stream.Events
+		require.Equal(t, fmt.Sprintf("log %d", i), e.Message)
+	}
+	require.NoError(t, stream.Close())
+	require.NoError(t, stream.Close())
+	_, ok := <-stream.Events
+	require.False(t, ok)
+	require.NoError(t, stream.Err())
+
+	// cancelling the context ends the stream
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err = client.StreamLogs(ctx, &LogStreamOptions{Target: "milli=trace", Mode: LogModeJSON})
+	require.NoError(t, err)
+	cancel()
+	for range stream.Events {
+	}
+	require.NoError(t, stream.Err())
+
+	require.NoError(t, client.UpdateStderrLogs("index_scheduler=debug"))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"POST /logs/stream", "DELETE /logs/stream", "POST /logs/stream", "POST /logs/stderr"}, requests)
+}
+
+func TestStreamLogs_DefaultMode(t *testing.T) {
+	var mode LogMode
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/experimental-features":
+			_, _ = w.Write([]byte(`{"logsRoute": true}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/logs/stream":
+			var opts LogStreamOptions
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&opts))
+			mode = opts.Mode
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer ts.Close()
+
+	options := &LogStreamOptions{Target: "milli=trace"}
+	stream, err := New(ts.URL).StreamLogs(context.Background(), options)
+	require.NoError(t, err)
+	require.NoError(t, stream.Close())
+	require.Equal(t, LogModeHuman, mode)
+	require.Equal(t, LogMode(""), options.Mode)
+}
@@ -16,7 +16,9 @@ type meilisearch struct {
 
 // New create new service manager for operating on meilisearch
 func New(host string, options ...Option) ServiceManager {
-	defOpt := defaultMeiliOpt
+	// options must not change the defaults of the next clients
+	opt := *defaultMeiliOpt
+	defOpt := &opt
 
 	for _, opt := range options {
 		opt(defOpt)
@@ -30,9 +32,18 @@ func New(host string, options ...Option) ServiceManager {
 			clientConfig{
 				contentEncoding:          defOpt.contentEncoding.encodingType,
 				encodingCompressionLevel: defOpt.contentEncoding.level,
+				acceptEncodings:          defOpt.acceptEncodings,
+				compressionThreshold:     defOpt.compressionThreshold,
 				disableRetry:             defOpt.disableRetry,
 				retryOnStatus:            defOpt.retryOnStatus,
 				maxRetries:               defOpt.maxRetries,
+				autoEnableFeatures:       defOpt.autoEnableFeatures,
+				indexTemplates:           defOpt.indexTemplates,
+				bodyCapture:              defOpt.bodyCapture,
+				logger:                   defOpt.logger,
+				logBodies:                defOpt.logBodies,
+				jsonCodec:                defOpt.jsonCodec,
+				connectionStats:          defOpt.connectionStats,
 			},
 		),
 	}
@@ -165,6 +176,29 @@ func (m *meilisearch) CreateIndex(config *IndexConfig) (*TaskInfo, error) {
 }
 
 func (m *meilisearch) CreateIndexWithContext(ctx context.Context, config *IndexConfig) (*TaskInfo, error) {
+	template, err := m.client.indexTemplate(config.Uid)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return m.createIndex(ctx, config)
+	}
+
+	if config.PrimaryKey == "" {
+		config = &IndexConfig{Uid: config.Uid, PrimaryKey: template.PrimaryKey}
+	}
+	task, err := m.createIndex(ctx, config)
+	if err != nil || template.Settings == nil {
+		return task, err
+	}
+	// the settings would create the index if the creation failed, eg. when it already exists
+	if err := m.client.waitForSuccess(ctx, task); err != nil {
+		return nil, err
+	}
+	return newIndex(m.client, config.Uid).UpdateSettingsWithContext(ctx, template.Settings)
+}
+
+func (m *meilisearch) createIndex(ctx context.Context, config *IndexConfig) (*TaskInfo, error) {
 	request := &CreateIndexRequest{
 		UID:        config.Uid,
 		PrimaryKey: config.PrimaryKey,
@@ -212,8 +246,12 @@ func (m *meilisearch) MultiSearch(queries *MultiSearchRequest) (*MultiSearchResp
 func (m *meilisearch) MultiSearchWithContext(ctx context.Context, queries *MultiSearchRequest) (*MultiSearchResponse, error) {
 	resp := new(MultiSearchResponse)
 
+	var feature ExperimentalFeature
 	for i := 0; i < len(queries.Queries); i++ {
 		queries.Queries[i].validate()
+		if f := filterFeature(queries.Queries[i].Filter); f != "" {
+			feature = f
+		}
 	}
 
 	req := &internalRequest{
@@ -224,6 +262,7 @@ func (m *meilisearch) MultiSearchWithContext(ctx context.Context, queries *Multi
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "MultiSearch",
+		experimentalFeature: feature,
 	}
 
 	if err := m.client.executeRequest(ctx, req); err != nil {
@@ -656,9 +695,16 @@ func getTask(ctx context.Context, cli *client, taskUID int64) (*Task, error) {
 }
 
 func waitForTask(ctx context.Context, cli *client, taskUID int64, interval time.Duration) (*Task, error) {
+	return pollTask(ctx, cli, taskUID, interval, nil)
+}
+
+// pollTask gets the task every interval until it is finished, onPending is called with the task
+// after every poll which found it enqueued or processing
+func pollTask(ctx context.Context, cli *client, taskUID int64, interval time.Duration, onPending func(*Task) error) (*Task, error) {
 	if interval == 0 {
 		interval = 50 * time.Millisecond
 	}
+	start := time.Now()
 
 	// extract closure to get the task and check the status first before the ticker
 	fn := func() (*Task, error) {
@@ -667,9 +713,14 @@ func waitForTask(ctx context.Context, cli *client, taskUID int64, interval time.
 			return nil, err
 		}
 
+		cli.logTaskWait(ctx, getTask, time.Since(start))
+
 		if getTask.Status != TaskStatusEnqueued && getTask.Status != TaskStatusProcessing {
 			return getTask, nil
 		}
+		if onPending != nil {
+			return nil, onPending(getTask)
+		}
 		return nil, nil
 	}
 
@@ -2,6 +2,7 @@ package meilisearch
 
 import (
 	"context"
+	"iter"
 	"time"
 )
 
@@ -18,7 +19,9 @@ type ServiceManager interface {
 	KeyManager() KeyManager
 	KeyReader() KeyReader
 
-	// CreateIndex creates a new index.
+	// CreateIndex creates a new index. When the uid matches an index template registered with
+	// WithIndexTemplates, its settings are applied once the index is created and the returned task is
+	// the settings update. The creation error is returned, eg. APIErrorCodeIndexAlreadyExists, when it fails.
 	CreateIndex(config *IndexConfig) (*TaskInfo, error)
 
 	// CreateIndexWithContext creates a new index with a context for cancellation.
@@ -36,6 +39,20 @@ type ServiceManager interface {
 	// SwapIndexesWithContext swaps the positions of two indexes with a context for cancellation.
 	SwapIndexesWithContext(ctx context.Context, param []*SwapIndexesParams) (*TaskInfo, error)
 
+	// SetAlias swaps alias with the index uid so alias serves its documents and settings, alias is created when missing.
+	// When deletePrevious is true, uid, which holds the previous content of alias after the swap, is deleted once
+	// the swap succeeded. The tasks are the swap then the deletion.
+	SetAlias(alias, uid string, deletePrevious bool) ([]*TaskInfo, error)
+
+	// SetAliasWithContext swaps alias with the index uid with a context for cancellation.
+	SetAliasWithContext(ctx context.Context, alias, uid string, deletePrevious bool) ([]*TaskInfo, error)
+
+	// ApplyRetention deletes the indexes matching a retention rule which are older than its max age.
+	ApplyRetention(rules ...RetentionRule) ([]*TaskInfo, error)
+
+	// ApplyRetentionWithContext deletes the indexes matching a retention rule with a context for cancellation.
+	ApplyRetentionWithContext(ctx context.Context, rules ...RetentionRule) ([]*TaskInfo, error)
+
 	// GenerateTenantToken generates a tenant token for multi-tenancy.
 	GenerateTenantToken(apiKeyUID string, searchRules map[string]interface{}, options *TenantTokenOptions) (string, error)
 
@@ -54,6 +71,15 @@ type ServiceManager interface {
 	// ExperimentalFeatures returns the experimental features manager.
 	ExperimentalFeatures() *ExperimentalFeatures
 
+	// StreamLogs streams the engine logs until the context is cancelled or the stream is closed.
+	StreamLogs(ctx context.Context, options *LogStreamOptions) (*LogStream, error)
+
+	// UpdateStderrLogs changes the target of the logs written on the standard error of the Meilisearch server.
+	UpdateStderrLogs(target string) error
+
+	// UpdateStderrLogsWithContext changes the target of the logs written on the standard error of the Meilisearch server with a context for cancellation.
+	UpdateStderrLogsWithContext(ctx context.Context, target string) error
+
 	// Close closes the connection to the Meilisearch server.
 	Close()
 }
@@ -80,6 +106,9 @@ type ServiceReader interface {
 	// ListIndexesWithContext lists all indexes with a context for cancellation.
 	ListIndexesWithContext(ctx context.Context, param *IndexesQuery) (*IndexesResults, error)
 
+	// AllIndexes iterates over all the indexes, fetching the pages of ListIndexes as needed.
+	AllIndexes(ctx context.Context, param *IndexesQuery) iter.Seq2[*IndexResult, error]
+
 	// GetRawIndexes fetches the raw JSON representation of all indexes.
 	GetRawIndexes(param *IndexesQuery) (map[string]interface{}, error)
 
@@ -98,6 +127,12 @@ type ServiceReader interface {
 	// GetStatsWithContext fetches global stats with a context for cancellation.
 	GetStatsWithContext(ctx context.Context) (*Stats, error)
 
+	// GetMetrics fetches and parses the Prometheus metrics, the metrics experimental feature must be enabled.
+	GetMetrics() (*Metrics, error)
+
+	// GetMetricsWithContext fetches and parses the Prometheus metrics with a context for cancellation.
+	GetMetricsWithContext(ctx context.Context) (*Metrics, error)
+
 	// Version fetches the version of the Meilisearch server.
 	Version() (*Version, error)
 
@@ -148,6 +183,9 @@ type KeyReader interface {
 
 	// GetKeysWithContext lists all API keys with a context for cancellation.
 	GetKeysWithContext(ctx context.Context, param *KeysQuery) (*KeysResults, error)
+
+	// AllKeys iterates over all the API keys, fetching the pages of GetKeys as needed.
+	AllKeys(ctx context.Context, param *KeysQuery) iter.Seq2[Key, error]
 }
 
 type TaskManager interface {
@@ -179,9 +217,28 @@ type TaskReader interface {
 	// GetTasksWithContext retrieves multiple tasks based on query parameters using the provided context for cancellation.
 	GetTasksWithContext(ctx context.Context, param *TasksQuery) (*TaskResult, error)
 
+	// AllTasks iterates over the tasks matching the query, following the from/next cursor of GetTasks.
+	AllTasks(ctx context.Context, param *TasksQuery) iter.Seq2[Task, error]
+
 	// WaitForTask waits for a task to complete by its UID with the given interval.
 	WaitForTask(taskUID int64, interval time.Duration) (*Task, error)
 
 	// WaitForTaskWithContext waits for a task to complete by its UID with the given interval using the provided context for cancellation.
 	WaitForTaskWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error)
+
+	// WaitForTaskWithProgress waits for a task to complete and calls fn with the progress of the batch processing it, fn may be nil.
+	// A failure to get the batch skips the progress of the poll, only a failure to get the task ends the wait.
+	WaitForTaskWithProgress(ctx context.Context, taskUID int64, interval time.Duration, fn func(TaskProgress)) (*Task, error)
+
+	// GetBatch retrieves a batch by its UID.
+	GetBatch(batchUID int64) (*Batch, error)
+
+	// GetBatchWithContext retrieves a batch by its UID using the provided context for cancellation.
+	GetBatchWithContext(ctx context.Context, batchUID int64) (*Batch, error)
+
+	// GetBatches retrieves multiple batches based on query parameters.
+	GetBatches(param *BatchesQuery) (*BatchesResults, error)
+
+	// GetBatchesWithContext retrieves multiple batches based on query parameters using the provided context for cancellation.
+	GetBatchesWithContext(ctx context.Context, param *BatchesQuery) (*BatchesResults, error)
 }
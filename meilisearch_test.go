@@ -56,7 +56,7 @@ func TestClient_TimeoutError(t *testing.T) {
 			name: "TestTimeoutError",
 			sv:   sv,
 			expectedError: Error{
-				MeilisearchApiError: meilisearchApiError{},
+				MeilisearchApiError: APIError{},
 			},
 		},
 	}
@@ -639,7 +639,8 @@ func Test_CreateDump(t *testing.T) {
 			require.NotNil(t, taskInfo)
 			require.NotNil(t, taskInfo.Details)
 			require.Equal(t, TaskStatusSucceeded, taskInfo.Status)
-			require.NotEmpty(t, taskInfo.Details.DumpUid)
+			require.IsType(t, &DumpCreationDetails{}, taskInfo.Details)
+			require.NotEmpty(t, taskInfo.Details.(*DumpCreationDetails).DumpUID)
 		})
 	}
 }
@@ -717,8 +718,10 @@ func Test_GetTask(t *testing.T) {
 			require.GreaterOrEqual(t, gotResp.UID, tt.args.taskUID)
 			require.Equal(t, tt.args.UID, gotResp.IndexUID)
 			require.Equal(t, TaskStatusSucceeded, gotResp.Status)
-			require.Equal(t, int64(len(tt.args.document)), gotResp.Details.ReceivedDocuments)
-			require.Equal(t, int64(len(tt.args.document)), gotResp.Details.IndexedDocuments)
+			require.Equal(t, &DocumentAdditionDetails{
+				ReceivedDocuments: int64(len(tt.args.document)),
+				IndexedDocuments:  int64(len(tt.args.document)),
+			}, gotResp.Details)
 
 			// Make sure that timestamps are also retrieved
 			require.NotZero(t, gotResp.EnqueuedAt)
@@ -1328,7 +1331,8 @@ func Test_CancelTasks(t *testing.T) {
 				require.NotNil(t, gotResp.EnqueuedAt)
 				require.Equal(t, "", gotResp.IndexUID)
 				require.Equal(t, TaskTypeTaskCancelation, gotResp.Type)
-				require.Equal(t, tt.want, gotTask.Details.OriginalFilter)
+				require.IsType(t, &TaskCancelationDetails{}, gotTask.Details)
+				require.Equal(t, tt.want, gotTask.Details.(*TaskCancelationDetails).OriginalFilter)
 			}
 		})
 	}
@@ -1459,8 +1463,8 @@ func Test_DeleteTasks(t *testing.T) {
 			require.NotNil(t, gotResp.EnqueuedAt)
 			require.Equal(t, "", gotResp.IndexUID)
 			require.Equal(t, TaskTypeTaskDeletion, gotResp.Type)
-			require.NotNil(t, gotTask.Details.OriginalFilter)
-			require.Equal(t, tt.want, gotTask.Details.OriginalFilter)
+			require.IsType(t, &TaskDeletionDetails{}, gotTask.Details)
+			require.Equal(t, tt.want, gotTask.Details.(*TaskDeletionDetails).OriginalFilter)
 		})
 	}
 }
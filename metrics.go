@@ -0,0 +1,483 @@
+package meilisearch
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricType is the type of a Prometheus metric family
+type MetricType string
+
+const (
+	MetricTypeCounter   MetricType = "counter"
+	MetricTypeGauge     MetricType = "gauge"
+	MetricTypeHistogram MetricType = "histogram"
+	MetricTypeSummary   MetricType = "summary"
+	MetricTypeUntyped   MetricType = "untyped"
+)
+
+// MetricSample is a single sample of the Prometheus exposition format, eg.
+// `meilisearch_index_docs_count{index="movies"} 31944`
+type MetricSample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// MetricFamily groups the samples of a metric with its HELP and TYPE metadata. The samples of
+// histograms include their `_bucket`, `_sum` and `_count` series.
+type MetricFamily struct {
+	Name    string
+	Help    string
+	Type    MetricType
+	Samples []MetricSample
+}
+
+// ParsePrometheusText parses metrics in the Prometheus text exposition format
+//
+// Documentation: https://prometheus.io/docs/instrumenting/exposition_formats/#text-based-format
+func ParsePrometheusText(r io.Reader) (map[string]*MetricFamily, error) {
+	families := map[string]*MetricFamily{}
+	family := func(name string) *MetricFamily {
+		if f, ok := families[name]; ok {
+			return f
+		}
+		f := &MetricFamily{Name: name, Type: MetricTypeUntyped}
+		families[name] = f
+		return f
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		if strings.HasPrefix(text, "#") {
+			fields := strings.SplitN(strings.TrimSpace(text[1:]), " ", 3)
+			if len(fields) < 3 {
+				continue
+			}
+			switch fields[0] {
+			case "HELP":
+				family(fields[1]).Help = fields[2]
+			case "TYPE":
+				family(fields[1]).Type = MetricType(fields[2])
+			}
+			continue
+		}
+
+		sample, err := parseMetricSample(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidMetrics, line, err)
+		}
+		f := family(metricFamilyName(families, sample.Name))
+		f.Samples = append(f.Samples, sample)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return families, nil
+}
+
+// metricFamilyName returns the family of a sample, histogram and summary series are suffixed
+func metricFamilyName(families map[string]*MetricFamily, name string) string {
+	if _, ok := families[name]; ok {
+		return name
+	}
+	for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+		base := strings.TrimSuffix(name, suffix)
+		if f, ok := families[base]; ok && base != name && (f.Type == MetricTypeHistogram || f.Type == MetricTypeSummary) {
+			return base
+		}
+	}
+	return name
+}
+
+func parseMetricSample(text string) (MetricSample, error) {
+	sample := MetricSample{Labels: map[string]string{}}
+
+	end := strings.IndexAny(text, "{ \t")
+	if end <= 0 {
+		return sample, fmt.Errorf("missing value in %q", text)
+	}
+	sample.Name = text[:end]
+	rest := text[end:]
+
+	if rest[0] == '{' {
+		i := 1
+		for {
+			for i < len(rest) && (rest[i] == ' ' || rest[i] == ',') {
+				i++
+			}
+			if i >= len(rest) {
+				return sample, fmt.Errorf("unterminated labels in %q", text)
+			}
+			if rest[i] == '}' {
+				i++
+				break
+			}
+
+			eq := strings.IndexByte(rest[i:], '=')
+			if eq < 0 || i+eq+1 >= len(rest) || rest[i+eq+1] != '"' {
+				return sample, fmt.Errorf("invalid label in %q", text)
+			}
+			name := strings.TrimSpace(rest[i : i+eq])
+			i += eq + 2
+
+			var value strings.Builder
+			for ; i < len(rest) && rest[i] != '"'; i++ {
+				if rest[i] == '\\' && i+1 < len(rest) {
+					i++
+					switch rest[i] {
+					case 'n':
+						value.WriteByte('\n')
+					default:
+						value.WriteByte(rest[i])
+					}
+					continue
+				}
+				value.WriteByte(rest[i])
+			}
+			if i >= len(rest) {
+				return sample, fmt.Errorf("unterminated label value in %q", text)
+			}
+			i++
+			sample.Labels[name] = value.String()
+		}
+		rest = rest[i:]
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return sample, fmt.Errorf("missing value in %q", text)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return sample, fmt.Errorf("invalid value in %q", text)
+	}
+	sample.Value = value
+	return sample, nil
+}
+
+// HTTPRequestsMetric is the number of HTTP requests received for a route
+type HTTPRequestsMetric struct {
+	Method string
+	Path   string
+	Status string
+	Count  float64
+}
+
+// HistogramBucket is the number of observations lower or equal to UpperBound
+type HistogramBucket struct {
+	UpperBound float64
+	Count      float64
+}
+
+// HTTPResponseTimeMetric is the histogram of the response times of a route, in seconds
+type HTTPResponseTimeMetric struct {
+	Method  string
+	Path    string
+	Count   float64
+	Sum     float64
+	Buckets []HistogramBucket
+}
+
+// Mean returns the average response time, 0 without observations
+func (m HTTPResponseTimeMetric) Mean() time.Duration {
+	if m.Count == 0 {
+		return 0
+	}
+	return time.Duration(m.Sum / m.Count * float64(time.Second))
+}
+
+// Quantile estimates the q-quantile (0 <= q <= 1) of the response times from the buckets, by
+// linear interpolation like the Prometheus histogram_quantile function
+func (m HTTPResponseTimeMetric) Quantile(q float64) time.Duration {
+	if len(m.Buckets) == 0 || m.Count == 0 {
+		return 0
+	}
+	rank := q * m.Count
+	lower, lowerCount := 0.0, 0.0
+	for _, b := range m.Buckets {
+		if b.Count >= rank {
+			if math.IsInf(b.UpperBound, 1) {
+				return time.Duration(lower * float64(time.Second))
+			}
+			fraction := 0.0
+			if b.Count > lowerCount {
+				fraction = (rank - lowerCount) / (b.Count - lowerCount)
+			}
+			return time.Duration((lower + (b.UpperBound-lower)*fraction) * float64(time.Second))
+		}
+		lower, lowerCount = b.UpperBound, b.Count
+	}
+	return time.Duration(lower * float64(time.Second))
+}
+
+// TaskQueueMetrics is the number of tasks by status, type and index
+type TaskQueueMetrics struct {
+	ByStatus map[TaskStatus]int64
+	ByType   map[TaskType]int64
+	ByIndex  map[string]int64
+}
+
+// Queued returns the number of enqueued and processing tasks
+func (m TaskQueueMetrics) Queued() int64 {
+	return m.ByStatus[TaskStatusEnqueued] + m.ByStatus[TaskStatusProcessing]
+}
+
+// Metrics is the content of the /metrics route. Families holds every metric returned by the
+// instance, the other fields are decoded from the well-known meilisearch metrics.
+type Metrics struct {
+	FetchedAt time.Time
+
+	IndexCount       int64
+	IndexDocsCount   map[string]int64
+	DBSizeBytes      int64
+	UsedDBSizeBytes  int64
+	IsIndexing       bool
+	LastUpdate       time.Time
+	HTTPRequests     []HTTPRequestsMetric
+	HTTPResponseTime []HTTPResponseTimeMetric
+	Tasks            TaskQueueMetrics
+
+	Families map[string]*MetricFamily
+}
+
+// TotalHTTPRequests returns the number of HTTP requests received for all routes
+func (m *Metrics) TotalHTTPRequests() float64 {
+	var total float64
+	for _, r := range m.HTTPRequests {
+		total += r.Count
+	}
+	return total
+}
+
+// NewMetrics decodes the well-known meilisearch metrics from the parsed families
+func NewMetrics(families map[string]*MetricFamily) *Metrics {
+	m := &Metrics{
+		IndexDocsCount: map[string]int64{},
+		Tasks: TaskQueueMetrics{
+			ByStatus: map[TaskStatus]int64{},
+			ByType:   map[TaskType]int64{},
+			ByIndex:  map[string]int64{},
+		},
+		Families: families,
+	}
+	samples := func(name string) []MetricSample {
+		if f, ok := families[name]; ok {
+			return f.Samples
+		}
+		return nil
+	}
+
+	for _, s := range samples("meilisearch_index_count") {
+		m.IndexCount = int64(s.Value)
+	}
+	for _, s := range samples("meilisearch_index_docs_count") {
+		m.IndexDocsCount[s.Labels["index"]] = int64(s.Value)
+	}
+	for _, s := range samples("meilisearch_db_size_bytes") {
+		m.DBSizeBytes = int64(s.Value)
+	}
+	for _, s := range samples("meilisearch_used_db_size_bytes") {
+		m.UsedDBSizeBytes = int64(s.Value)
+	}
+	for _, s := range samples("meilisearch_is_indexing") {
+		m.IsIndexing = s.Value != 0
+	}
+	for _, s := range samples("meilisearch_last_update") {
+		if s.Value > 0 {
+			m.LastUpdate = time.Unix(int64(s.Value), 0)
+		}
+	}
+	for _, s := range samples("meilisearch_http_requests_total") {
+		m.HTTPRequests = append(m.HTTPRequests, HTTPRequestsMetric{
+			Method: s.Labels["method"],
+			Path:   s.Labels["path"],
+			Status: s.Labels["status"],
+			Count:  s.Value,
+		})
+	}
+	for _, s := range samples("meilisearch_nb_tasks") {
+		switch s.Labels["kind"] {
+		case "statuses":
+			m.Tasks.ByStatus[TaskStatus(s.Labels["value"])] = int64(s.Value)
+		case "types":
+			m.Tasks.ByType[TaskType(s.Labels["value"])] = int64(s.Value)
+		case "indexes":
+			m.Tasks.ByIndex[s.Labels["value"]] = int64(s.Value)
+		}
+	}
+
+	routes := map[[2]string]*HTTPResponseTimeMetric{}
+	var keys [][2]string
+	for _, s := range samples("meilisearch_http_response_time_seconds") {
+		key := [2]string{s.Labels["method"], s.Labels["path"]}
+		r, ok := routes[key]
+		if !ok {
+			r = &HTTPResponseTimeMetric{Method: key[0], Path: key[1]}
+			routes[key] = r
+			keys = append(keys, key)
+		}
+		switch {
+		case strings.HasSuffix(s.Name, "_bucket"):
+			bound, err := strconv.ParseFloat(s.Labels["le"], 64)
+			if err == nil {
+				r.Buckets = append(r.Buckets, HistogramBucket{UpperBound: bound, Count: s.Value})
+			}
+		case strings.HasSuffix(s.Name, "_sum"):
+			r.Sum = s.Value
+		case strings.HasSuffix(s.Name, "_count"):
+			r.Count = s.Value
+		}
+	}
+	for _, key := range keys {
+		r := routes[key]
+		sort.Slice(r.Buckets, func(i, j int) bool { return r.Buckets[i].UpperBound < r.Buckets[j].UpperBound })
+		m.HTTPResponseTime = append(m.HTTPResponseTime, *r)
+	}
+	return m
+}
+
+func (m *meilisearch) GetMetrics() (*Metrics, error) {
+	return m.GetMetricsWithContext(context.Background())
+}
+
+func (m *meilisearch) GetMetricsWithContext(ctx context.Context) (*Metrics, error) {
+	req := &internalRequest{
+		endpoint:            "/metrics",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        nil,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetMetrics",
+		experimentalFeature: ExperimentalFeatureMetrics,
+	}
+	resp, err := m.client.executeStreamRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	families, err := ParsePrometheusText(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	metrics := NewMetrics(families)
+	metrics.FetchedAt = time.Now()
+	return metrics, nil
+}
+
+// MetricsDelta is the change of the metrics between two polls
+type MetricsDelta struct {
+	Previous *Metrics
+	Current  *Metrics
+	Elapsed  time.Duration
+
+	// IndexDocsCount is the change of the number of documents by index
+	IndexDocsCount map[string]int64
+	DBSizeBytes    int64
+	HTTPRequests   float64
+	TasksQueued    int64
+}
+
+// RequestsPerSecond returns the HTTP request rate between the two polls
+func (d *MetricsDelta) RequestsPerSecond() float64 {
+	if d.Elapsed <= 0 {
+		return 0
+	}
+	return d.HTTPRequests / d.Elapsed.Seconds()
+}
+
+// NewMetricsDelta computes the change of the metrics from previous to current
+func NewMetricsDelta(previous, current *Metrics) *MetricsDelta {
+	d := &MetricsDelta{
+		Previous:       previous,
+		Current:        current,
+		Elapsed:        current.FetchedAt.Sub(previous.FetchedAt),
+		IndexDocsCount: map[string]int64{},
+		DBSizeBytes:    current.DBSizeBytes - previous.DBSizeBytes,
+		HTTPRequests:   current.TotalHTTPRequests() - previous.TotalHTTPRequests(),
+		TasksQueued:    current.Tasks.Queued() - previous.Tasks.Queued(),
+	}
+	for index, count := range current.IndexDocsCount {
+		d.IndexDocsCount[index] = count - previous.IndexDocsCount[index]
+	}
+	for index, count := range previous.IndexDocsCount {
+		if _, ok := current.IndexDocsCount[index]; !ok {
+			d.IndexDocsCount[index] = -count
+		}
+	}
+	return d
+}
+
+// DefaultMetricsPollerInterval is the time between two polls of a MetricsPoller
+const DefaultMetricsPollerInterval = 10 * time.Second
+
+// MetricsPoller fetches the metrics periodically and reports their change since the previous poll
+type MetricsPoller struct {
+	client   ServiceReader
+	interval time.Duration
+	previous *Metrics
+}
+
+// NewMetricsPoller returns a MetricsPoller fetching the metrics every interval,
+// DefaultMetricsPollerInterval when it is not positive
+func NewMetricsPoller(client ServiceReader, interval time.Duration) *MetricsPoller {
+	if interval <= 0 {
+		interval = DefaultMetricsPollerInterval
+	}
+	return &MetricsPoller{client: client, interval: interval}
+}
+
+// Poll fetches the metrics and returns their change since the previous call, the first call
+// returns a nil delta
+func (p *MetricsPoller) Poll(ctx context.Context) (*Metrics, *MetricsDelta, error) {
+	current, err := p.client.GetMetricsWithContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var delta *MetricsDelta
+	if p.previous != nil {
+		delta = NewMetricsDelta(p.previous, current)
+	}
+	p.previous = current
+	return current, delta, nil
+}
+
+// Run polls the metrics every interval and calls fn with the change until ctx is done. A failed
+// poll is given to fn and the next delta is computed from the last successful poll.
+func (p *MetricsPoller) Run(ctx context.Context, fn func(*MetricsDelta, error)) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, delta, err := p.Poll(ctx); err != nil || delta != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			fn(delta, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
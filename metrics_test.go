@@ -0,0 +1,141 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testMetrics = `# HELP meilisearch_db_size_bytes Meilisearch DB Size In Bytes
+# TYPE meilisearch_db_size_bytes gauge
+meilisearch_db_size_bytes 1130496
+# HELP meilisearch_used_db_size_bytes Meilisearch Used DB Size In Bytes
+# TYPE meilisearch_used_db_size_bytes gauge
+meilisearch_used_db_size_bytes 409600
+# HELP meilisearch_index_count Meilisearch Index Count
+# TYPE meilisearch_index_count gauge
+meilisearch_index_count 2
+# HELP meilisearch_index_docs_count Meilisearch Index Docs Count
+# TYPE meilisearch_index_docs_count gauge
+meilisearch_index_docs_count{index="movies"} 31944
+meilisearch_index_docs_count{index="books"} 10
+# HELP meilisearch_is_indexing Meilisearch Is Indexing
+# TYPE meilisearch_is_indexing gauge
+meilisearch_is_indexing 1
+# HELP meilisearch_last_update Meilisearch Last Update
+# TYPE meilisearch_last_update gauge
+meilisearch_last_update 1691589600
+# HELP meilisearch_http_requests_total Meilisearch HTTP requests total
+# TYPE meilisearch_http_requests_total counter
+meilisearch_http_requests_total{method="GET",path="/metrics",status="200"} 2
+meilisearch_http_requests_total{method="POST",path="/indexes/{index_uid}/search",status="200"} 40
+# HELP meilisearch_http_response_time_seconds Meilisearch HTTP response times
+# TYPE meilisearch_http_response_time_seconds histogram
+meilisearch_http_response_time_seconds_bucket{method="POST",path="/indexes/{index_uid}/search",le="0.005"} 0
+meilisearch_http_response_time_seconds_bucket{method="POST",path="/indexes/{index_uid}/search",le="0.01"} 2
+meilisearch_http_response_time_seconds_bucket{method="POST",path="/indexes/{index_uid}/search",le="+Inf"} 4
+meilisearch_http_response_time_seconds_sum{method="POST",path="/indexes/{index_uid}/search"} 0.1
+meilisearch_http_response_time_seconds_count{method="POST",path="/indexes/{index_uid}/search"} 4
+# HELP meilisearch_nb_tasks Meilisearch Number of tasks
+# TYPE meilisearch_nb_tasks gauge
+meilisearch_nb_tasks{kind="indexes",value="movies"} 3
+meilisearch_nb_tasks{kind="statuses",value="enqueued"} 2
+meilisearch_nb_tasks{kind="statuses",value="processing"} 1
+meilisearch_nb_tasks{kind="statuses",value="succeeded"} 7
+meilisearch_nb_tasks{kind="types",value="documentAdditionOrUpdate"} 5
+`
+
+func TestParsePrometheusText(t *testing.T) {
+	families, err := ParsePrometheusText(strings.NewReader(testMetrics + `
+# a comment
+untyped_metric{label="a \"quoted\"\nvalue",other="x"} -Inf 1691589600000
+`))
+	require.NoError(t, err)
+
+	f := families["meilisearch_http_response_time_seconds"]
+	require.Equal(t, MetricTypeHistogram, f.Type)
+	require.Equal(t, "Meilisearch HTTP response times", f.Help)
+	require.Len(t, f.Samples, 5)
+
+	f = families["untyped_metric"]
+	require.Equal(t, MetricTypeUntyped, f.Type)
+	require.Equal(t, map[string]string{"label": "a \"quoted\"\nvalue", "other": "x"}, f.Samples[0].Labels)
+
+	for _, text := range []string{"metric{", `metric{a="b} 1`, "metric", "metric abc", `metric{a=b} 1`} {
+		_, err = ParsePrometheusText(strings.NewReader(text))
+		require.ErrorIs(t, err, ErrInvalidMetrics, text)
+	}
+}
+
+func TestNewMetrics(t *testing.T) {
+	families, err := ParsePrometheusText(strings.NewReader(testMetrics))
+	require.NoError(t, err)
+	m := NewMetrics(families)
+
+	require.Equal(t, int64(2), m.IndexCount)
+	require.Equal(t, map[string]int64{"movies": 31944, "books": 10}, m.IndexDocsCount)
+	require.Equal(t, int64(1130496), m.DBSizeBytes)
+	require.Equal(t, int64(409600), m.UsedDBSizeBytes)
+	require.True(t, m.IsIndexing)
+	require.Equal(t, time.Unix(1691589600, 0), m.LastUpdate)
+	require.Equal(t, float64(42), m.TotalHTTPRequests())
+	require.Equal(t, int64(3), m.Tasks.Queued())
+	require.Equal(t, int64(5), m.Tasks.ByType[TaskTypeDocumentAdditionOrUpdate])
+	require.Equal(t, int64(3), m.Tasks.ByIndex["movies"])
+
+	require.Len(t, m.HTTPResponseTime, 1)
+	rt := m.HTTPResponseTime[0]
+	require.Equal(t, "/indexes/{index_uid}/search", rt.Path)
+	require.Equal(t, 25*time.Millisecond, rt.Mean())
+	require.Equal(t, 7500*time.Microsecond, rt.Quantile(0.25))
+	require.Equal(t, 10*time.Millisecond, rt.Quantile(0.5))
+	require.Equal(t, 10*time.Millisecond, rt.Quantile(1))
+}
+
+func TestMetricsPoller(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/metrics", r.URL.Path)
+		if atomic.AddInt32(&calls, 1) == 1 {
+			_, _ = w.Write([]byte(testMetrics))
+			return
+		}
+		_, _ = w.Write([]byte(strings.NewReplacer(
+			`{index="movies"} 31944`, `{index="movies"} 32000`,
+			`status="200"} 40`, `status="200"} 50`,
+			`value="enqueued"} 2`, `value="enqueued"} 0`,
+			`meilisearch_index_docs_count{index="books"} 10`, ``,
+		).Replace(testMetrics)))
+	}))
+	defer ts.Close()
+
+	poller := NewMetricsPoller(New(ts.URL), time.Millisecond)
+	m, delta, err := poller.Poll(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, delta)
+	require.Equal(t, int64(2), m.IndexCount)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var got *MetricsDelta
+	err = poller.Run(ctx, func(d *MetricsDelta, err error) {
+		require.NoError(t, err)
+		got = d
+		cancel()
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	require.Equal(t, map[string]int64{"movies": 56, "books": -10}, got.IndexDocsCount)
+	require.Equal(t, float64(10), got.HTTPRequests)
+	require.Equal(t, int64(-2), got.TasksQueued)
+	require.True(t, got.Elapsed > 0)
+	require.True(t, got.RequestsPerSecond() > 0)
+
+	require.Equal(t, DefaultMetricsPollerInterval, NewMetricsPoller(New(ts.URL), 0).interval)
+	require.Equal(t, DefaultMetricsPollerInterval, NewMetricsPoller(New(ts.URL), -time.Second).interval)
+}
@@ -1,10 +1,10 @@
 package meilisearch
 
 import (
+	"context"
 	"crypto/tls"
-	"net"
+	"log/slog"
 	"net/http"
-	"time"
 )
 
 var (
@@ -29,9 +29,20 @@ type meiliOpt struct {
 	client          *http.Client
 	apiKey          string
 	contentEncoding *encodingOpt
-	retryOnStatus   map[int]bool
-	disableRetry    bool
-	maxRetries      uint8
+	// acceptEncodings are the encodings of the responses accepted by the client
+	acceptEncodings      []ContentEncoding
+	compressionThreshold int
+	retryOnStatus        map[int]bool
+	disableRetry         bool
+	maxRetries           uint8
+
+	autoEnableFeatures bool
+	indexTemplates     []IndexTemplate
+	bodyCapture        *BodyCapture
+	logger             *slog.Logger
+	logBodies          bool
+	jsonCodec          JSONCodec
+	connectionStats    func(ctx context.Context, stats ConnectionStats)
 }
 
 type encodingOpt struct {
@@ -48,10 +59,15 @@ func WithCustomClient(client *http.Client) Option {
 	}
 }
 
-// WithCustomClientWithTLS client support tls configuration
+// WithCustomClientWithTLS client support tls configuration, the transport set by WithTransportOptions is kept
 func WithCustomClientWithTLS(tlsConfig *tls.Config) Option {
 	return func(opt *meiliOpt) {
-		trans := baseTransport()
+		trans, ok := opt.client.Transport.(*http.Transport)
+		if ok {
+			trans = trans.Clone()
+		} else {
+			trans = baseTransport()
+		}
 		trans.TLSClientConfig = tlsConfig
 		opt.client = &http.Client{Transport: trans}
 	}
@@ -70,8 +86,24 @@ func WithAPIKey(key string) Option {
 // compression improves transfer speed and reduces bandwidth consumption by sending and receiving smaller payloads.
 // the Accept-Encoding header, instead, indicates the compression algorithm the client understands.
 //
+// WithContentEncoding compresses the requests and accepts the responses with the same algorithm, use WithRequestEncoding
+// and WithAcceptEncodings to configure them separately.
+//
 // more: https://www.meilisearch.com/docs/reference/api/overview#content-encoding
 func WithContentEncoding(encodingType ContentEncoding, level EncodingCompressionLevel) Option {
+	return func(opt *meiliOpt) {
+		opt.contentEncoding = &encodingOpt{
+			encodingType: encodingType,
+			level:        level,
+		}
+		opt.acceptEncodings = []ContentEncoding{encodingType}
+	}
+}
+
+// WithRequestEncoding compresses the request bodies with the algorithm, the responses are not affected.
+//
+// more: https://www.meilisearch.com/docs/reference/api/overview#content-encoding
+func WithRequestEncoding(encodingType ContentEncoding, level EncodingCompressionLevel) Option {
 	return func(opt *meiliOpt) {
 		opt.contentEncoding = &encodingOpt{
 			encodingType: encodingType,
@@ -80,6 +112,24 @@ func WithContentEncoding(encodingType ContentEncoding, level EncodingCompression
 	}
 }
 
+// WithAcceptEncodings sets the algorithms the responses may be compressed with, from the preferred one.
+// The responses are decompressed according to their Content-Encoding header.
+//
+// more: https://www.meilisearch.com/docs/reference/api/overview#content-encoding
+func WithAcceptEncodings(encodings ...ContentEncoding) Option {
+	return func(opt *meiliOpt) {
+		opt.acceptEncodings = encodings
+	}
+}
+
+// WithCompressionThreshold sends the request bodies smaller than minSize bytes uncompressed, compressing small
+// bodies costs more than it saves. The streamed bodies, whose size is unknown, are always compressed.
+func WithCompressionThreshold(minSize int) Option {
+	return func(opt *meiliOpt) {
+		opt.compressionThreshold = minSize
+	}
+}
+
 // WithCustomRetries set retry on specific http error code and max retries (min: 1, max: 255)
 func WithCustomRetries(retryOnStatus []int, maxRetries uint8) Option {
 	return func(opt *meiliOpt) {
@@ -103,17 +153,96 @@ func DisableRetries() Option {
 	}
 }
 
-func baseTransport() *http.Transport {
-	return &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			Timeout:   30 * time.Second,
-			KeepAlive: 30 * time.Second,
-		}).DialContext,
-		MaxIdleConns:          100,
-		MaxIdleConnsPerHost:   100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
+// WithAutoEnableExperimentalFeatures enables the experimental features needed by a method when
+// they are disabled on the instance, instead of returning an ExperimentalFeatureError. The API key
+// must be allowed to update the experimental features.
+//
+// more: https://www.meilisearch.com/docs/reference/api/experimental_features
+func WithAutoEnableExperimentalFeatures() Option {
+	return func(opt *meiliOpt) {
+		opt.autoEnableFeatures = true
+	}
+}
+
+// WithIndexTemplates registers templates applied by CreateIndex to the indexes whose uid matches
+// their pattern, the first matching template is used.
+func WithIndexTemplates(templates ...IndexTemplate) Option {
+	return func(opt *meiliOpt) {
+		opt.indexTemplates = append(opt.indexTemplates[:len(opt.indexTemplates):len(opt.indexTemplates)], templates...)
+	}
+}
+
+// WithErrorBodyCapture controls how the request and response bodies are captured in the errors, they
+// can be omitted, redacted or truncated to keep documents and secrets out of the logs. By default the
+// bodies are captured entirely.
+//
+//	meilisearch.WithErrorBodyCapture(meilisearch.BodyCapture{Redact: meilisearch.RedactSecrets, MaxBytes: 1024})
+func WithErrorBodyCapture(capture BodyCapture) Option {
+	return func(opt *meiliOpt) {
+		opt.bodyCapture = &capture
+	}
+}
+
+// WithLogger logs the requests and the tasks waited for with the logger: the requests and their
+// duration, status and retries at debug level and the retries at warn level.
+func WithLogger(logger *slog.Logger) Option {
+	return func(opt *meiliOpt) {
+		opt.logger = logger
 	}
 }
+
+// WithBodyLogging logs the request and response bodies at debug level with the logger given to
+// WithLogger. The secrets are redacted and the bodies are truncated like in WithErrorBodyCapture.
+func WithBodyLogging() Option {
+	return func(opt *meiliOpt) {
+		opt.logBodies = true
+	}
+}
+
+// WithTransportOptions tunes the timeouts, the connection pool and the protocols of the client. Only the
+// transport of the client is replaced: the Timeout of a client given before by WithCustomClient is kept,
+// and so is the TLS configuration given before by WithCustomClientWithTLS when TransportOptions.TLSConfig
+// is nil. A client given after it by WithCustomClient is used as is, with its own transport.
+//
+//	meilisearch.WithTransportOptions(meilisearch.TransportOptions{ResponseHeaderTimeout: 5 * time.Second, H2C: true})
+func WithTransportOptions(transport TransportOptions) Option {
+	return func(opt *meiliOpt) {
+		if transport.TLSConfig == nil {
+			if trans, ok := opt.client.Transport.(*http.Transport); ok {
+				transport.TLSConfig = trans.TLSClientConfig
+			}
+		}
+		// the client is copied, it may be shared with the caller or the other clients
+		client := *opt.client
+		client.Transport = newTransport(transport)
+		opt.client = &client
+	}
+}
+
+// WithConnectionStats calls fn after every attempt of a request with the statistics of its connection:
+// whether it was reused from the pool and the time spent on DNS, dialing and TLS.
+func WithConnectionStats(fn func(ctx context.Context, stats ConnectionStats)) Option {
+	return func(opt *meiliOpt) {
+		opt.connectionStats = fn
+	}
+}
+
+// WithJSONCodec marshals the requests, including the documents uploaded one by one, and unmarshals the
+// responses, the error bodies, the log events and the hits and documents of TypedIndex with the codec.
+// By default EasyJSONCodec is used.
+//
+// SearchStream and GetDocumentsStream split the response with encoding/json and pass the hits raw to
+// the callback, only their other fields go through the codec. DecodeGeoDistance, DecodeGeoPoint,
+// DecodeRankingScoreDetails and Explain have no client and always use EasyJSONCodec, the highlight
+// package uses encoding/json.
+//
+//	meilisearch.WithJSONCodec(meilisearch.NewJSONCodec(sonic.Marshal, sonic.Unmarshal))
+func WithJSONCodec(codec JSONCodec) Option {
+	return func(opt *meiliOpt) {
+		opt.jsonCodec = codec
+	}
+}
+
+func baseTransport() *http.Transport {
+	return newTransport(TransportOptions{})
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestOptions_WithCustomClient(t *testing.T) {
@@ -48,8 +49,29 @@ func TestOptions_WithContentEncoding(t *testing.T) {
 	m, ok := meili.(*meilisearch)
 	require.True(t, ok)
 
-	require.Equal(t, m.client.contentEncoding, GzipEncoding)
-	require.NotNil(t, m.client.encoder)
+	require.Equal(t, m.client.requestEncoding.Load().encoding, GzipEncoding)
+	require.NotNil(t, m.client.requestEncoding.Load().encoder)
+	require.Equal(t, m.client.acceptEncoding, "gzip")
+}
+
+func TestOptions_WithRequestAndAcceptEncodings(t *testing.T) {
+	meili := setup(t, "", WithRequestEncoding(ZstdEncoding, BestSpeed),
+		WithAcceptEncodings(BrotliEncoding, GzipEncoding), WithCompressionThreshold(1024))
+	require.NotNil(t, meili)
+
+	m, ok := meili.(*meilisearch)
+	require.True(t, ok)
+
+	require.Equal(t, m.client.requestEncoding.Load().encoding, ZstdEncoding)
+	require.Equal(t, m.client.compressionLevel, BestSpeed)
+	require.Equal(t, m.client.acceptEncoding, "br, gzip")
+	require.Equal(t, m.client.compressionThreshold, 1024)
+
+	meili = setup(t, "", WithRequestEncoding(GzipEncoding, DefaultCompression))
+	m, ok = meili.(*meilisearch)
+	require.True(t, ok)
+	require.Equal(t, m.client.requestEncoding.Load().encoding, GzipEncoding)
+	require.Empty(t, m.client.acceptEncoding)
 }
 
 func TestOptions_WithCustomRetries(t *testing.T) {
@@ -78,3 +100,40 @@ func TestOptions_DisableRetries(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, m.client.disableRetry, true)
 }
+
+func TestOptions_WithAutoEnableExperimentalFeatures(t *testing.T) {
+	meili := setup(t, "", WithAutoEnableExperimentalFeatures())
+	require.NotNil(t, meili)
+
+	m, ok := meili.(*meilisearch)
+	require.True(t, ok)
+
+	require.True(t, m.client.autoEnableFeatures)
+}
+
+func TestOptions_WithTransportOptions(t *testing.T) {
+	custom := &http.Client{Timeout: 5 * time.Second}
+	tl := new(tls.Config)
+
+	meili := setup(t, "", WithCustomClient(custom), WithTransportOptions(TransportOptions{MaxConnsPerHost: 7}))
+	c := meili.(*meilisearch).client.client
+	require.Equal(t, 5*time.Second, c.Timeout)
+	require.Equal(t, 7, c.Transport.(*http.Transport).MaxConnsPerHost)
+	require.Nil(t, custom.Transport)
+
+	meili = setup(t, "", WithTransportOptions(TransportOptions{MaxConnsPerHost: 7}), WithCustomClient(custom))
+	require.Equal(t, custom, meili.(*meilisearch).client.client)
+
+	meili = setup(t, "", WithCustomClientWithTLS(tl), WithTransportOptions(TransportOptions{MaxConnsPerHost: 7}))
+	tr := meili.(*meilisearch).client.client.Transport.(*http.Transport)
+	require.Equal(t, tl, tr.TLSClientConfig)
+	require.Equal(t, 7, tr.MaxConnsPerHost)
+
+	meili = setup(t, "", WithTransportOptions(TransportOptions{MaxConnsPerHost: 7}), WithCustomClientWithTLS(tl))
+	tr = meili.(*meilisearch).client.client.Transport.(*http.Transport)
+	require.Equal(t, tl, tr.TLSClientConfig)
+	require.Equal(t, 7, tr.MaxConnsPerHost)
+
+	require.Nil(t, defaultMeiliOpt.client.Transport.(*http.Transport).TLSClientConfig)
+	require.Zero(t, defaultMeiliOpt.client.Transport.(*http.Transport).MaxConnsPerHost)
+}
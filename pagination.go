@@ -0,0 +1,155 @@
+package meilisearch
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"strconv"
+)
+
+// DefaultListPageSize is the number of indexes, keys or tasks fetched per request by AllIndexes,
+// AllKeys and AllTasks when the query has no limit
+const DefaultListPageSize int64 = 100
+
+// pageFetcher fetches the page starting at cursor, nil for the first page, and returns the cursor
+// of the next page, nil after the last one
+type pageFetcher[T any] func(ctx context.Context, cursor *int64) (items []T, next *int64, err error)
+
+type fetchedPage[T any] struct {
+	items []T
+	next  *int64
+	err   error
+}
+
+// paginate iterates over the pages returned by fetch, the next page is fetched while the items of
+// the current one are yielded. The iteration stops after the first error.
+func paginate[T any](ctx context.Context, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		prefetch := func(cursor *int64) <-chan fetchedPage[T] {
+			ch := make(chan fetchedPage[T], 1)
+			go func() {
+				items, next, err := fetch(ctx, cursor)
+				ch <- fetchedPage[T]{items: items, next: next, err: err}
+			}()
+			return ch
+		}
+
+		pending := prefetch(nil)
+		for pending != nil {
+			page := <-pending
+			if page.err != nil {
+				yield(*new(T), page.err)
+				return
+			}
+
+			pending = nil
+			if page.next != nil {
+				pending = prefetch(page.next)
+			}
+			for _, item := range page.items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// nextOffset returns the offset of the page after the one at offset, nil after the last page
+func nextOffset(offset int64, count int, total int64) *int64 {
+	next := offset + int64(count)
+	if count == 0 || next >= total {
+		return nil
+	}
+	return &next
+}
+
+func (m *meilisearch) AllIndexes(ctx context.Context, param *IndexesQuery) iter.Seq2[*IndexResult, error] {
+	query := IndexesQuery{Limit: DefaultListPageSize}
+	if param != nil {
+		query = *param
+		if query.Limit <= 0 {
+			query.Limit = DefaultListPageSize
+		}
+	}
+
+	return paginate(ctx, func(ctx context.Context, cursor *int64) ([]*IndexResult, *int64, error) {
+		page := query
+		if cursor != nil {
+			page.Offset = *cursor
+		}
+		resp, err := m.ListIndexesWithContext(ctx, &page)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Results, nextOffset(page.Offset, len(resp.Results), resp.Total), nil
+	})
+}
+
+func (m *meilisearch) AllKeys(ctx context.Context, param *KeysQuery) iter.Seq2[Key, error] {
+	query := KeysQuery{Limit: DefaultListPageSize}
+	if param != nil {
+		query = *param
+		if query.Limit <= 0 {
+			query.Limit = DefaultListPageSize
+		}
+	}
+
+	return paginate(ctx, func(ctx context.Context, cursor *int64) ([]Key, *int64, error) {
+		page := query
+		if cursor != nil {
+			page.Offset = *cursor
+		}
+		resp, err := m.GetKeysWithContext(ctx, &page)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Results, nextOffset(page.Offset, len(resp.Results), resp.Total), nil
+	})
+}
+
+// tasksPage is a page of /tasks, unlike TaskResult it tells a null next, after the last page,
+// from a next task of uid 0
+type tasksPage struct {
+	Results []Task `json:"results"`
+	Next    *int64 `json:"next"`
+}
+
+func (m *meilisearch) AllTasks(ctx context.Context, param *TasksQuery) iter.Seq2[Task, error] {
+	query := TasksQuery{Limit: DefaultListPageSize}
+	if param != nil {
+		query = *param
+	}
+	return allTasks(ctx, m.client, query)
+}
+
+// allTasks iterates over the tasks matching query by following the from/next cursor of /tasks
+func allTasks(ctx context.Context, c *client, query TasksQuery) iter.Seq2[Task, error] {
+	if query.Limit <= 0 {
+		query.Limit = DefaultListPageSize
+	}
+
+	return paginate(ctx, func(ctx context.Context, cursor *int64) ([]Task, *int64, error) {
+		resp := new(tasksPage)
+		req := &internalRequest{
+			endpoint:            "/tasks",
+			method:              http.MethodGet,
+			withResponse:        resp,
+			withQueryParams:     map[string]string{},
+			acceptedStatusCodes: []int{http.StatusOK},
+			functionName:        "GetTasks",
+		}
+		encodeTasksQuery(&query, req)
+		// encodeTasksQuery skips a zero from, which is a valid cursor once the first page is fetched
+		if cursor != nil {
+			req.withQueryParams["from"] = strconv.FormatInt(*cursor, 10)
+		}
+		if err := c.executeRequest(ctx, req); err != nil {
+			return nil, nil, err
+		}
+		return resp.Results, resp.Next, nil
+	})
+}
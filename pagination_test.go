@@ -0,0 +1,125 @@
+package meilisearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllIndexesAndKeys(t *testing.T) {
+	var mu sync.Mutex
+	var queries []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.Path+"?"+r.URL.RawQuery)
+		mu.Unlock()
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var items []string
+		for i := offset; i < offset+limit && i < 7; i++ {
+			if r.URL.Path == "/keys" {
+				items = append(items, fmt.Sprintf(`{"uid":"key-%d"}`, i))
+			} else {
+				items = append(items, fmt.Sprintf(`{"uid":"index-%d"}`, i))
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"results":[%s],"offset":%d,"limit":%d,"total":7}`, strings.Join(items, ","), offset, limit)
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL)
+
+	var uids []string
+	for idx, err := range client.AllIndexes(context.Background(), &IndexesQuery{Limit: 3}) {
+		require.NoError(t, err)
+		require.NotNil(t, idx.IndexManager)
+		uids = append(uids, idx.UID)
+	}
+	require.Equal(t, []string{"index-0", "index-1", "index-2", "index-3", "index-4", "index-5", "index-6"}, uids)
+	require.Equal(t, []string{
+		"/indexes?limit=3",
+		"/indexes?limit=3&offset=3",
+		"/indexes?limit=3&offset=6",
+	}, queries)
+
+	queries = nil
+	uids = nil
+	for key, err := range client.AllKeys(context.Background(), &KeysQuery{Offset: 5}) {
+		require.NoError(t, err)
+		uids = append(uids, key.UID)
+	}
+	require.Equal(t, []string{"key-5", "key-6"}, uids)
+	require.Equal(t, []string{"/keys?limit=100&offset=5"}, queries)
+}
+
+func TestAllTasks(t *testing.T) {
+	var mu sync.Mutex
+	var queries []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mu.Unlock()
+
+		if r.URL.Query().Get("statuses") == "failed" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"bad","code":"invalid_task_statuses","type":"invalid_request","link":""}`))
+			return
+		}
+
+		// tasks 4 to 0 in descending order, next is 0 before the last page and null after it
+		from := 4
+		if r.URL.Query().Has("from") {
+			from, _ = strconv.Atoi(r.URL.Query().Get("from"))
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var items []string
+		uid := from
+		for ; uid >= 0 && len(items) < limit; uid-- {
+			items = append(items, fmt.Sprintf(`{"uid":%d,"indexUid":"movies"}`, uid))
+		}
+		next := "null"
+		if uid >= 0 {
+			next = strconv.Itoa(uid)
+		}
+		_, _ = fmt.Fprintf(w, `{"results":[%s],"limit":%d,"from":%d,"next":%s,"total":5}`, strings.Join(items, ","), limit, from, next)
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL)
+
+	var uids []int64
+	for task, err := range client.AllTasks(context.Background(), &TasksQuery{Limit: 2}) {
+		require.NoError(t, err)
+		uids = append(uids, task.UID)
+	}
+	require.Equal(t, []int64{4, 3, 2, 1, 0}, uids)
+	require.Equal(t, []string{"limit=2", "from=2&limit=2", "from=0&limit=2"}, queries)
+
+	// breaking out of the loop stops the iteration, the next page is already prefetched
+	queries = nil
+	for task, err := range client.Index("movies").AllTasks(context.Background(), &TasksQuery{Limit: 2}) {
+		require.NoError(t, err)
+		require.Equal(t, int64(4), task.UID)
+		break
+	}
+	mu.Lock()
+	require.Equal(t, "indexUids=movies&limit=2", queries[0])
+	mu.Unlock()
+
+	var errs int
+	for _, err := range client.AllTasks(context.Background(), &TasksQuery{Statuses: []TaskStatus{TaskStatusFailed}}) {
+		require.Error(t, err)
+		errs++
+	}
+	require.Equal(t, 1, errs)
+}
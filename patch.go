@@ -0,0 +1,227 @@
+package meilisearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+type patchOperation int
+
+const (
+	patchSet patchOperation = iota
+	patchUnset
+	patchIncrement
+	patchAppend
+	patchRemove
+)
+
+type patchOp struct {
+	op    patchOperation
+	path  []string
+	value interface{}
+}
+
+// DocumentPatch is a list of field-level operations applied to documents by PatchDocuments and
+// PatchDocumentsByFilter. Fields are named with dotted paths for nested objects (`info.stock`).
+//
+// A patch made only of Set operations on top-level fields is sent as a partial UpdateDocuments
+// payload. Any other operation needs the current values of the documents, the patch is then
+// compiled to a Rhai function sent to UpdateDocumentsByFunction with the values given through its
+// Context, this requires the `editDocumentsByFunction` experimental feature. PatchDocuments then
+// selects the documents with a filter on the primary key, which must be a filterable attribute of
+// the index, otherwise ErrPrimaryKeyNotFilterable is returned before anything is sent.
+//
+//	patch := meilisearch.NewDocumentPatch().
+//		Increment("stock", -1).
+//		AppendToArray("tags", "sale").
+//		Unset("draft")
+type DocumentPatch struct {
+	ops []patchOp
+}
+
+// NewDocumentPatch returns an empty DocumentPatch
+func NewDocumentPatch() *DocumentPatch {
+	return &DocumentPatch{}
+}
+
+func (p *DocumentPatch) add(op patchOperation, field string, value interface{}) *DocumentPatch {
+	p.ops = append(p.ops, patchOp{op: op, path: strings.Split(field, "."), value: value})
+	return p
+}
+
+// Set sets the field to value
+func (p *DocumentPatch) Set(field string, value interface{}) *DocumentPatch {
+	return p.add(patchSet, field, value)
+}
+
+// Unset removes the field from the documents
+func (p *DocumentPatch) Unset(field string) *DocumentPatch {
+	return p.add(patchUnset, field, nil)
+}
+
+// Increment adds delta to the numeric field, a missing field is set to delta. Use a negative delta
+// to decrement.
+func (p *DocumentPatch) Increment(field string, delta float64) *DocumentPatch {
+	return p.add(patchIncrement, field, delta)
+}
+
+// AppendToArray appends values to the array field, a missing field is set to values
+func (p *DocumentPatch) AppendToArray(field string, values ...interface{}) *DocumentPatch {
+	return p.add(patchAppend, field, values)
+}
+
+// RemoveFromArray removes all the occurrences of values from the array field
+func (p *DocumentPatch) RemoveFromArray(field string, values ...interface{}) *DocumentPatch {
+	return p.add(patchRemove, field, values)
+}
+
+func (p *DocumentPatch) validate() error {
+	if p == nil || len(p.ops) == 0 {
+		return ErrEmptyPatch
+	}
+	for _, op := range p.ops {
+		for _, name := range op.path {
+			if name == "" {
+				return fmt.Errorf("%w: invalid field %q", ErrInvalidPatch, strings.Join(op.path, "."))
+			}
+		}
+	}
+	return nil
+}
+
+// NeedsFunction reports whether the patch reads the current values of the documents and must be
+// compiled to a function
+func (p *DocumentPatch) NeedsFunction() bool {
+	for _, op := range p.ops {
+		if op.op != patchSet || len(op.path) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// Documents compiles the patch to the partial documents to send to UpdateDocuments, one per
+// identifier. It fails with ErrPatchNeedsFunction when NeedsFunction is true.
+func (p *DocumentPatch) Documents(primaryKey string, identifiers ...string) ([]map[string]interface{}, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if p.NeedsFunction() {
+		return nil, ErrPatchNeedsFunction
+	}
+
+	fields := make(map[string]interface{}, len(p.ops))
+	for _, op := range p.ops {
+		if op.path[0] == primaryKey {
+			return nil, fmt.Errorf("%w: the primary key %q can not be patched", ErrInvalidPatch, primaryKey)
+		}
+		fields[op.path[0]] = op.value
+	}
+
+	docs := make([]map[string]interface{}, 0, len(identifiers))
+	for _, id := range identifiers {
+		doc := make(map[string]interface{}, len(fields)+1)
+		for name, value := range fields {
+			doc[name] = value
+		}
+		doc[primaryKey] = id
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// Function compiles the patch to a Rhai function applied to the documents matching filter, an
+// empty filter applies it to all the documents. The values of the operations are passed in the
+// Context of the request and never interpolated in the function.
+func (p *DocumentPatch) Function(filter string) (*UpdateDocumentByFunctionRequest, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	ctx := make(map[string]interface{}, len(p.ops))
+	for i, op := range p.ops {
+		param := "v" + strconv.Itoa(i)
+		if op.op != patchUnset {
+			ctx[param] = op.value
+		}
+		value := "context." + param
+
+		parent := "doc"
+		for _, name := range op.path[:len(op.path)-1] {
+			// intermediate objects are created for the operations writing a value
+			field := parent + "[" + rhaiString(name) + "]"
+			if op.op != patchUnset && op.op != patchRemove {
+				fmt.Fprintf(&b, "if type_of(%s) != \"map\" { %s = #{}; }\n", field, field)
+			}
+			parent = field
+		}
+		name := rhaiString(op.path[len(op.path)-1])
+		field := parent + "[" + name + "]"
+
+		// nested fields are only removed from existing objects
+		guard := ""
+		if parent != "doc" {
+			guard = "type_of(" + parent + ") == \"map\" && "
+		}
+
+		switch op.op {
+		case patchSet:
+			fmt.Fprintf(&b, "%s = %s;\n", field, value)
+		case patchUnset:
+			fmt.Fprintf(&b, "if %s%s.contains(%s) { %s.remove(%s); }\n", guard, parent, name, parent, name)
+		case patchIncrement:
+			fmt.Fprintf(&b, "if type_of(%s) == \"()\" { %s = %s; } else { %s += %s; }\n", field, field, value, field, value)
+		case patchAppend:
+			fmt.Fprintf(&b, "if type_of(%s) != \"array\" { %s = []; }\n%s += %s;\n", field, field, field, value)
+		case patchRemove:
+			fmt.Fprintf(&b, "if %stype_of(%s) == \"array\" {\n"+
+				"\tlet kept = [];\n"+
+				"\tfor v in %s { if !%s.contains(v) { kept.push(v); } }\n"+
+				"\t%s = kept;\n"+
+				"}\n", guard, field, field, value, field)
+		}
+	}
+
+	return &UpdateDocumentByFunctionRequest{
+		Filter:   filter,
+		Function: b.String(),
+		Context:  ctx,
+	}, nil
+}
+
+// rhaiString returns s as a Rhai string literal, Rhai supports the JSON escape sequences
+func rhaiString(s string) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// isFilterable reports whether attribute is matched by one of the filterable attributes, they may be
+// patterns such as `*` or `product.*`
+func isFilterable(attribute string, filterable *[]string) bool {
+	if filterable == nil {
+		return false
+	}
+	for _, pattern := range *filterable {
+		if ok, _ := path.Match(pattern, attribute); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// filterIn returns a filter matching the documents whose attribute is one of values
+func filterIn(attribute string, values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"`
+	}
+	return attribute + " IN [" + strings.Join(quoted, ", ") + "]"
+}
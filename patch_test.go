@@ -0,0 +1,145 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentPatch_Documents(t *testing.T) {
+	patch := NewDocumentPatch().Set("price", 9.5).Set("title", "Carol")
+	require.False(t, patch.NeedsFunction())
+
+	docs, err := patch.Documents("id", "1", "2")
+	require.NoError(t, err)
+	require.Equal(t, []map[string]interface{}{
+		{"id": "1", "price": 9.5, "title": "Carol"},
+		{"id": "2", "price": 9.5, "title": "Carol"},
+	}, docs)
+
+	_, err = NewDocumentPatch().Set("id", "3").Documents("id", "1")
+	require.ErrorIs(t, err, ErrInvalidPatch)
+
+	_, err = NewDocumentPatch().Set("info.year", 1).Documents("id", "1")
+	require.ErrorIs(t, err, ErrPatchNeedsFunction)
+
+	_, err = NewDocumentPatch().Increment("stock", 1).Documents("id", "1")
+	require.ErrorIs(t, err, ErrPatchNeedsFunction)
+
+	_, err = NewDocumentPatch().Documents("id", "1")
+	require.ErrorIs(t, err, ErrEmptyPatch)
+
+	_, err = NewDocumentPatch().Set("info.", 1).Function("")
+	require.ErrorIs(t, err, ErrInvalidPatch)
+}
+
+func TestDocumentPatch_Function(t *testing.T) {
+	patch := NewDocumentPatch().
+		Set("info.year", 1994).
+		Unset("draft").
+		Unset("info.comment").
+		Increment("stock", -1).
+		AppendToArray("tags", "sale", "new").
+		RemoveFromArray("tags", `"old"`)
+	require.True(t, patch.NeedsFunction())
+
+	req, err := patch.Function(`genres = "fiction"`)
+	require.NoError(t, err)
+	require.Equal(t, `genres = "fiction"`, req.Filter)
+	require.Equal(t, map[string]interface{}{
+		"v0": 1994,
+		"v3": float64(-1),
+		"v4": []interface{}{"sale", "new"},
+		"v5": []interface{}{`"old"`},
+	}, req.Context)
+	require.Equal(t, `if type_of(doc["info"]) != "map" { doc["info"] = #{}; }
+doc["info"]["year"] = context.v0;
+if doc.contains("draft") { doc.remove("draft"); }
+if type_of(doc["info"]) == "map" && doc["info"].contains("comment") { doc["info"].remove("comment"); }
+if type_of(doc["stock"]) == "()" { doc["stock"] = context.v3; } else { doc["stock"] += context.v3; }
+if type_of(doc["tags"]) != "array" { doc["tags"] = []; }
+doc["tags"] += context.v4;
+if type_of(doc["tags"]) == "array" {
+	let kept = [];
+	for v in doc["tags"] { if !context.v5.contains(v) { kept.push(v); } }
+	doc["tags"] = kept;
+}
+`, req.Function)
+
+	// field names are quoted, values are never part of the function
+	req, err = NewDocumentPatch().Set(`a"b`, "x\"; doc = ()").Function("")
+	require.NoError(t, err)
+	require.Equal(t, "doc[\"a\\\"b\"] = context.v0;\n", req.Function)
+}
+
+func TestIndex_PatchDocuments(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]interface{}
+	var gotDocs []map[string]interface{}
+	filterable := `["stock"]`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/indexes/movies" {
+			_, _ = w.Write([]byte(`{"uid": "movies", "primaryKey": "id"}`))
+			return
+		}
+		if r.Method == http.MethodGet && r.URL.Path == "/indexes/movies/settings/filterable-attributes" {
+			_, _ = w.Write([]byte(filterable))
+			return
+		}
+
+		gotPath = r.URL.Path
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if r.URL.Path == "/indexes/movies/documents" {
+			require.NoError(t, json.Unmarshal(body, &gotDocs))
+		} else {
+			require.NoError(t, json.Unmarshal(body, &gotBody))
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid": 1, "indexUid": "movies", "status": "enqueued"}`))
+	}))
+	defer ts.Close()
+
+	idx := New(ts.URL).Index("movies")
+
+	_, err := idx.PatchDocuments(NewDocumentPatch().Set("price", 10))
+	require.ErrorIs(t, err, ErrNoDocumentIdentifiers)
+
+	_, err = idx.PatchDocuments(NewDocumentPatch().Set("price", 10), "1", "2")
+	require.NoError(t, err)
+	require.Equal(t, "/indexes/movies/documents", gotPath)
+	require.Equal(t, []map[string]interface{}{
+		{"id": "1", "price": float64(10)},
+		{"id": "2", "price": float64(10)},
+	}, gotDocs)
+
+	gotPath = ""
+	_, err = idx.PatchDocuments(NewDocumentPatch().Increment("stock", 2), "1")
+	require.ErrorIs(t, err, ErrPrimaryKeyNotFilterable)
+	require.Empty(t, gotPath)
+
+	filterable = `["stock", "id"]`
+	_, err = idx.PatchDocuments(NewDocumentPatch().Increment("stock", 2), "1", `a"b`)
+	require.NoError(t, err)
+	require.Equal(t, "/indexes/movies/documents/edit", gotPath)
+	require.Equal(t, `id IN ["1", "a\"b"]`, gotBody["filter"])
+	require.Equal(t, map[string]interface{}{"v0": float64(2)}, gotBody["context"])
+
+	_, err = idx.PatchDocumentsByFilter(NewDocumentPatch().Set("sold", true), "stock = 0")
+	require.NoError(t, err)
+	require.Equal(t, "/indexes/movies/documents/edit", gotPath)
+	require.Equal(t, "stock = 0", gotBody["filter"])
+}
+
+func TestDocumentPatch_IsFilterable(t *testing.T) {
+	require.False(t, isFilterable("id", nil))
+	require.False(t, isFilterable("id", &[]string{"stock", "identifier"}))
+	require.True(t, isFilterable("id", &[]string{"stock", "id"}))
+	require.True(t, isFilterable("id", &[]string{"*"}))
+	require.True(t, isFilterable("product.id", &[]string{"product.*"}))
+}
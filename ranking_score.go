@@ -0,0 +1,281 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RankingScoreDetails is the decoded `_rankingScoreDetails` object of a hit, returned when
+// SearchRequest.ShowRankingScoreDetails is set.
+//
+// Every ranking rule that took part in the ranking has its own field, the rules that were not used
+// are nil. Sort contains custom ranking rules and `sort` expressions (including `_geoPoint`) in
+// the order they were applied.
+//
+// Documentation: https://www.meilisearch.com/docs/reference/api/search#ranking-score-details
+type RankingScoreDetails struct {
+	Words     *WordsRankingDetails
+	Typo      *TypoRankingDetails
+	Proximity *ProximityRankingDetails
+	Attribute *AttributeRankingDetails
+	Exactness *ExactnessRankingDetails
+	Vector    *VectorRankingDetails
+	Sort      []SortRankingDetails
+	// Unknown holds the rules this version of the SDK does not know how to decode, by rule name.
+	Unknown map[string]json.RawMessage
+}
+
+// WordsRankingDetails details of the `words` ranking rule
+type WordsRankingDetails struct {
+	Order            int64   `json:"order"`
+	MatchingWords    int64   `json:"matchingWords"`
+	MaxMatchingWords int64   `json:"maxMatchingWords"`
+	Score            float64 `json:"score"`
+}
+
+// TypoRankingDetails details of the `typo` ranking rule
+type TypoRankingDetails struct {
+	Order        int64   `json:"order"`
+	TypoCount    int64   `json:"typoCount"`
+	MaxTypoCount int64   `json:"maxTypoCount"`
+	Score        float64 `json:"score"`
+}
+
+// ProximityRankingDetails details of the `proximity` ranking rule
+type ProximityRankingDetails struct {
+	Order int64   `json:"order"`
+	Score float64 `json:"score"`
+}
+
+// AttributeRankingDetails details of the `attribute` ranking rule
+type AttributeRankingDetails struct {
+	Order                      int64   `json:"order"`
+	AttributeRankingOrderScore float64 `json:"attributeRankingOrderScore"`
+	QueryWordDistanceScore     float64 `json:"queryWordDistanceScore"`
+	Score                      float64 `json:"score"`
+}
+
+// ExactnessRankingDetails details of the `exactness` ranking rule
+type ExactnessRankingDetails struct {
+	Order            int64   `json:"order"`
+	MatchType        string  `json:"matchType"`
+	MatchingWords    int64   `json:"matchingWords"`
+	MaxMatchingWords int64   `json:"maxMatchingWords"`
+	Score            float64 `json:"score"`
+}
+
+// VectorRankingDetails details of the vector ranking used by semantic and hybrid search
+type VectorRankingDetails struct {
+	Order      int64     `json:"order"`
+	Similarity float64   `json:"similarity"`
+	Value      []float64 `json:"value,omitempty"`
+}
+
+// SortRankingDetails details of a `sort` or custom ranking rule such as `release_date:desc`
+// or `_geoPoint(48.8,2.3):asc`.
+type SortRankingDetails struct {
+	// Rule is the rule as reported by meilisearch, eg. `release_date:desc`
+	Rule string `json:"-"`
+	// Attribute is the sorted attribute, eg. `release_date` or `_geoPoint(48.8,2.3)`
+	Attribute string `json:"-"`
+	// Ascending is false when the rule sorts in descending order
+	Ascending bool `json:"-"`
+
+	Order int64       `json:"order"`
+	Value interface{} `json:"value"`
+	// Distance is only set for `_geoPoint` rules, in meters.
+	Distance *float64 `json:"distance,omitempty"`
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (d *RankingScoreDetails) UnmarshalJSON(data []byte) error {
+	var rules map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return err
+	}
+
+	*d = RankingScoreDetails{}
+
+	for name, raw := range rules {
+		var target interface{}
+		switch name {
+		case "words":
+			d.Words = new(WordsRankingDetails)
+			target = d.Words
+		case "typo":
+			d.Typo = new(TypoRankingDetails)
+			target = d.Typo
+		case "proximity":
+			d.Proximity = new(ProximityRankingDetails)
+			target = d.Proximity
+		case "attribute":
+			d.Attribute = new(AttributeRankingDetails)
+			target = d.Attribute
+		case "exactness":
+			d.Exactness = new(ExactnessRankingDetails)
+			target = d.Exactness
+		case "vector", "vectorSort":
+			d.Vector = new(VectorRankingDetails)
+			target = d.Vector
+		default:
+			attribute, ascending, ok := splitSortRule(name)
+			if !ok {
+				if d.Unknown == nil {
+					d.Unknown = make(map[string]json.RawMessage)
+				}
+				d.Unknown[name] = raw
+				continue
+			}
+			rule := SortRankingDetails{Rule: name, Attribute: attribute, Ascending: ascending}
+			if err := json.Unmarshal(raw, &rule); err != nil {
+				return fmt.Errorf("unable to decode ranking rule %q: %w", name, err)
+			}
+			d.Sort = append(d.Sort, rule)
+			continue
+		}
+
+		if err := json.Unmarshal(raw, target); err != nil {
+			return fmt.Errorf("unable to decode ranking rule %q: %w", name, err)
+		}
+	}
+
+	sort.Slice(d.Sort, func(a, b int) bool {
+		return d.Sort[a].Order < d.Sort[b].Order
+	})
+
+	return nil
+}
+
+func splitSortRule(rule string) (attribute string, ascending bool, ok bool) {
+	switch {
+	case strings.HasSuffix(rule, ":asc"):
+		return strings.TrimSuffix(rule, ":asc"), true, true
+	case strings.HasSuffix(rule, ":desc"):
+		return strings.TrimSuffix(rule, ":desc"), false, true
+	default:
+		return "", false, false
+	}
+}
+
+type rankedHit struct {
+	RankingScore        *float64             `json:"_rankingScore"`
+	RankingScoreDetails *RankingScoreDetails `json:"_rankingScoreDetails"`
+}
+
+func decodeRankedHit(hit interface{}) (*rankedHit, error) {
+	r := new(rankedHit)
+	if err := decodeHit(hit, r, EasyJSONCodec); err != nil {
+		return nil, err
+	}
+	if r.RankingScoreDetails == nil {
+		return nil, ErrNoRankingScoreDetails
+	}
+	return r, nil
+}
+
+// DecodeRankingScoreDetails extracts the `_rankingScoreDetails` of a search hit.
+//
+// hit is one element of SearchResponse.Hits, a raw JSON hit or any value holding a
+// `_rankingScoreDetails` field once encoded in JSON.
+func DecodeRankingScoreDetails(hit interface{}) (*RankingScoreDetails, error) {
+	r, err := decodeRankedHit(hit)
+	if err != nil {
+		return nil, err
+	}
+	return r.RankingScoreDetails, nil
+}
+
+// RankingScoreDetails decodes the ranking score details of every hit of the response,
+// in the same order as Hits.
+func (r *SearchResponse) RankingScoreDetails() ([]*RankingScoreDetails, error) {
+	details := make([]*RankingScoreDetails, len(r.Hits))
+	for i, hit := range r.Hits {
+		d, err := DecodeRankingScoreDetails(hit)
+		if err != nil {
+			return nil, fmt.Errorf("hit %d: %w", i, err)
+		}
+		details[i] = d
+	}
+	return details, nil
+}
+
+// Explain renders a human-readable breakdown of the ranking of a hit: its global ranking score if
+// present, then every ranking rule in the order meilisearch applied them.
+func Explain(hit interface{}) (string, error) {
+	r, err := decodeRankedHit(hit)
+	if err != nil {
+		return "", err
+	}
+
+	type line struct {
+		order int64
+		text  string
+	}
+
+	d := r.RankingScoreDetails
+	lines := make([]line, 0)
+
+	if d.Words != nil {
+		lines = append(lines, line{d.Words.Order, fmt.Sprintf("words: %.4f (%d/%d query words matched)",
+			d.Words.Score, d.Words.MatchingWords, d.Words.MaxMatchingWords)})
+	}
+	if d.Typo != nil {
+		lines = append(lines, line{d.Typo.Order, fmt.Sprintf("typo: %.4f (%d typos, %d allowed)",
+			d.Typo.Score, d.Typo.TypoCount, d.Typo.MaxTypoCount)})
+	}
+	if d.Proximity != nil {
+		lines = append(lines, line{d.Proximity.Order, fmt.Sprintf("proximity: %.4f", d.Proximity.Score)})
+	}
+	if d.Attribute != nil {
+		lines = append(lines, line{d.Attribute.Order, fmt.Sprintf("attribute: %.4f (attribute ranking order %.4f, query word distance %.4f)",
+			d.Attribute.Score, d.Attribute.AttributeRankingOrderScore, d.Attribute.QueryWordDistanceScore)})
+	}
+	if d.Exactness != nil {
+		lines = append(lines, line{d.Exactness.Order, fmt.Sprintf("exactness: %.4f (%s, %d/%d words matched exactly)",
+			d.Exactness.Score, d.Exactness.MatchType, d.Exactness.MatchingWords, d.Exactness.MaxMatchingWords)})
+	}
+	if d.Vector != nil {
+		lines = append(lines, line{d.Vector.Order, fmt.Sprintf("vector: similarity %.4f", d.Vector.Similarity)})
+	}
+	for _, s := range d.Sort {
+		direction := "descending"
+		if s.Ascending {
+			direction = "ascending"
+		}
+		value := s.Value
+		if f, ok := value.(float64); ok {
+			value = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		text := fmt.Sprintf("sort %s %s: value %v", s.Attribute, direction, value)
+		if s.Distance != nil {
+			text += fmt.Sprintf(", distance %.0fm", *s.Distance)
+		}
+		lines = append(lines, line{s.Order, text})
+	}
+
+	sort.SliceStable(lines, func(a, b int) bool {
+		return lines[a].order < lines[b].order
+	})
+
+	unknown := make([]string, 0, len(d.Unknown))
+	for name := range d.Unknown {
+		unknown = append(unknown, name)
+	}
+	sort.Strings(unknown)
+
+	b := new(strings.Builder)
+	if r.RankingScore != nil {
+		fmt.Fprintf(b, "ranking score: %.4f\n", *r.RankingScore)
+	}
+	for i, l := range lines {
+		fmt.Fprintf(b, "%d. %s\n", i+1, l.text)
+	}
+	for _, name := range unknown {
+		fmt.Fprintf(b, "?. %s: %s\n", name, string(d.Unknown[name]))
+	}
+
+	return b.String(), nil
+}
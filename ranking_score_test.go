@@ -0,0 +1,76 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testRankedHit = []byte(`{
+	"id": 1,
+	"title": "Batman",
+	"_rankingScore": 0.9128,
+	"_rankingScoreDetails": {
+		"words": {"order": 0, "matchingWords": 1, "maxMatchingWords": 1, "score": 1.0},
+		"typo": {"order": 1, "typoCount": 0, "maxTypoCount": 1, "score": 1.0},
+		"proximity": {"order": 2, "score": 1.0},
+		"attribute": {"order": 3, "attributeRankingOrderScore": 1.0, "queryWordDistanceScore": 0.8, "score": 0.96},
+		"release_date:desc": {"order": 5, "value": 1714521600},
+		"exactness": {"order": 4, "matchType": "exactMatch", "matchingWords": 1, "maxMatchingWords": 1, "score": 1.0},
+		"_geoPoint(48.8,2.3):asc": {"order": 6, "value": [48.9, 2.4], "distance": 1320.5},
+		"futureRule": {"order": 7}
+	}
+}`)
+
+func TestDecodeRankingScoreDetails(t *testing.T) {
+	var hit map[string]interface{}
+	require.NoError(t, json.Unmarshal(testRankedHit, &hit))
+
+	for name, h := range map[string]interface{}{"map": hit, "raw": json.RawMessage(testRankedHit)} {
+		t.Run(name, func(t *testing.T) {
+			d, err := DecodeRankingScoreDetails(h)
+			require.NoError(t, err)
+
+			require.Equal(t, &WordsRankingDetails{Order: 0, MatchingWords: 1, MaxMatchingWords: 1, Score: 1}, d.Words)
+			require.Equal(t, &TypoRankingDetails{Order: 1, MaxTypoCount: 1, Score: 1}, d.Typo)
+			require.Equal(t, &ProximityRankingDetails{Order: 2, Score: 1}, d.Proximity)
+			require.Equal(t, 0.8, d.Attribute.QueryWordDistanceScore)
+			require.Equal(t, "exactMatch", d.Exactness.MatchType)
+			require.Nil(t, d.Vector)
+
+			require.Len(t, d.Sort, 2)
+			require.Equal(t, "release_date", d.Sort[0].Attribute)
+			require.False(t, d.Sort[0].Ascending)
+			require.Equal(t, "_geoPoint(48.8,2.3)", d.Sort[1].Attribute)
+			require.True(t, d.Sort[1].Ascending)
+			require.Equal(t, 1320.5, *d.Sort[1].Distance)
+
+			require.Contains(t, d.Unknown, "futureRule")
+		})
+	}
+}
+
+func TestDecodeRankingScoreDetails_Missing(t *testing.T) {
+	_, err := DecodeRankingScoreDetails(map[string]interface{}{"id": 1})
+	require.ErrorIs(t, err, ErrNoRankingScoreDetails)
+
+	resp := &SearchResponse{Hits: []interface{}{map[string]interface{}{"id": 1}}}
+	_, err = resp.RankingScoreDetails()
+	require.ErrorIs(t, err, ErrNoRankingScoreDetails)
+}
+
+func TestExplain(t *testing.T) {
+	got, err := Explain(json.RawMessage(testRankedHit))
+	require.NoError(t, err)
+	require.Equal(t, `ranking score: 0.9128
+1. words: 1.0000 (1/1 query words matched)
+2. typo: 1.0000 (0 typos, 1 allowed)
+3. proximity: 1.0000
+4. attribute: 0.9600 (attribute ranking order 1.0000, query word distance 0.8000)
+5. exactness: 1.0000 (exactMatch, 1/1 words matched exactly)
+6. sort release_date descending: value 1714521600
+7. sort _geoPoint(48.8,2.3) ascending: value [48.9 2.4], distance 1320m
+?. futureRule: {"order": 7}
+`, got)
+}
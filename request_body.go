@@ -0,0 +1,193 @@
+package meilisearch
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// bodySource opens the body of a streamed request, it is opened again when the request is retried
+type bodySource func() (io.ReadCloser, error)
+
+// marshalError is returned by the body of a streamed request when a document cannot be marshaled
+type marshalError struct {
+	err error
+}
+
+func (e *marshalError) Error() string {
+	return "failed to marshal with the JSON codec: " + e.err.Error()
+}
+
+func (e *marshalError) Unwrap() error {
+	return e.err
+}
+
+var (
+	errBodyNotReopenable = errors.New("request body cannot be read again")
+	errBodyReopened      = errors.New("request body was opened again")
+)
+
+// pipeReader reads what a goroutine writes, see pipeBody
+type pipeReader struct {
+	*io.PipeReader
+	done chan struct{}
+}
+
+// join closes the reader and waits for the goroutine writing it to return
+func (p *pipeReader) join() {
+	_ = p.Close()
+	<-p.done
+}
+
+// pipeBody returns a reader of what write writes from a goroutine, the reader returns the error of
+// write once everything is read. Closing the reader makes the writes fail so that write returns.
+func pipeBody(write func(w io.Writer) error) *pipeReader {
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = pw.CloseWithError(write(pw))
+	}()
+	return &pipeReader{PipeReader: pr, done: done}
+}
+
+// jsonSource encodes the documents one by one when they are read, so that the whole JSON array is
+// never held in memory
+func jsonSource(documents interface{}, codec JSONCodec) bodySource {
+	return func() (io.ReadCloser, error) {
+		return pipeBody(func(w io.Writer) error {
+			bw := bufio.NewWriterSize(w, 32*1024)
+			if err := encodeJSONStream(bw, documents, codec); err != nil {
+				return err
+			}
+			return bw.Flush()
+		}), nil
+	}
+}
+
+func encodeJSONStream(w io.Writer, documents interface{}, codec JSONCodec) error {
+	rv := reflect.ValueOf(documents)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	_, isMarshaler := documents.(json.Marshaler)
+	isList := (rv.Kind() == reflect.Slice && !rv.IsNil()) || rv.Kind() == reflect.Array
+	if isMarshaler || !isList || rv.Type().Elem().Kind() == reflect.Uint8 {
+		data, err := codec.Marshal(documents)
+		if err != nil {
+			return &marshalError{err: err}
+		}
+		_, err = w.Write(data)
+		return err
+	}
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		data, err := codec.Marshal(rv.Index(i).Interface())
+		if err != nil {
+			return &marshalError{err: err}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+// readerSource streams the reader, it can be opened again if the reader is an io.Seeker,
+// eg. an *os.File, otherwise reopenable is false and the request cannot be retried
+func readerSource(r io.Reader) (src bodySource, reopenable bool) {
+	if seeker, ok := r.(io.Seeker); ok {
+		if offset, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			if readerAt, ok := r.(io.ReaderAt); ok {
+				// every body reads its own section, the previous body may still be read by the transport
+				return func() (io.ReadCloser, error) {
+					return io.NopCloser(io.NewSectionReader(readerAt, offset, math.MaxInt64-offset)), nil
+				}, true
+			}
+
+			var previous *seekedReader
+			return func() (io.ReadCloser, error) {
+				if previous != nil {
+					// the previous body must not read anymore once r is seeked
+					previous.stop()
+				}
+				if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+					return nil, err
+				}
+				previous = &seekedReader{r: r}
+				return previous, nil
+			}, true
+		}
+	}
+
+	opened := false
+	return func() (io.ReadCloser, error) {
+		if opened {
+			return nil, errBodyNotReopenable
+		}
+		opened = true
+		return io.NopCloser(r), nil
+	}, false
+}
+
+// seekedReader reads r until it is stopped, see readerSource
+type seekedReader struct {
+	mu      sync.Mutex
+	r       io.Reader
+	stopped bool
+}
+
+func (s *seekedReader) Read(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return 0, errBodyReopened
+	}
+	return s.r.Read(p)
+}
+
+func (s *seekedReader) Close() error {
+	return nil
+}
+
+// stop waits for the read in progress, the next reads fail
+func (s *seekedReader) stop() {
+	s.mu.Lock()
+	s.stopped = true
+	s.mu.Unlock()
+}
+
+// encodedSource compresses the body opened by src with enc while it is read, the goroutine
+// compressing the previous body is joined before src is opened again
+func encodedSource(src bodySource, enc encoder) bodySource {
+	var previous *pipeReader
+	return func() (io.ReadCloser, error) {
+		if previous != nil {
+			previous.join()
+		}
+		body, err := src()
+		if err != nil {
+			return nil, err
+		}
+		previous = pipeBody(func(w io.Writer) error {
+			defer func() {
+				_ = body.Close()
+			}()
+			return enc.EncodeTo(w, body)
+		})
+		return previous, nil
+	}
+}
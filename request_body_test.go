@@ -0,0 +1,282 @@
+package meilisearch
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeJSONStream(t *testing.T) {
+	type movie struct {
+		ID    int    `json:"id"`
+		Title string `json:"title"`
+	}
+	movies := []movie{{ID: 1, Title: "Carol"}, {ID: 2, Title: "Wonder Woman"}}
+
+	tests := []struct {
+		name      string
+		documents interface{}
+		want      string
+	}{
+		{"slice", movies, `[{"id":1,"title":"Carol"},{"id":2,"title":"Wonder Woman"}]`},
+		{"pointer to slice", &movies, `[{"id":1,"title":"Carol"},{"id":2,"title":"Wonder Woman"}]`},
+		{"array", [1]map[string]interface{}{{"id": 3}}, `[{"id":3}]`},
+		{"empty slice", []movie{}, `[]`},
+		{"nil slice", []movie(nil), `null`},
+		{"single document", movie{ID: 1}, `{"id":1,"title":""}`},
+		{"raw message", json.RawMessage(`[{"id":4}]`), `[{"id":4}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := jsonSource(tt.documents, EasyJSONCodec)()
+			require.NoError(t, err)
+			data, err := io.ReadAll(body)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(data))
+		})
+	}
+
+	body, err := jsonSource([]interface{}{map[string]interface{}{"id": 1}, make(chan int)}, EasyJSONCodec)()
+	require.NoError(t, err)
+	_, err = io.ReadAll(body)
+	var marshalErr *marshalError
+	require.ErrorAs(t, err, &marshalErr)
+}
+
+func TestReaderSource(t *testing.T) {
+	src, reopenable := readerSource(io.MultiReader(strings.NewReader("foo")))
+	require.False(t, reopenable)
+	body, err := src()
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(data))
+	_, err = src()
+	require.ErrorIs(t, err, errBodyNotReopenable)
+
+	r := strings.NewReader("foobar")
+	_, _ = r.Seek(3, io.SeekStart)
+	src, reopenable = readerSource(r)
+	require.True(t, reopenable)
+	for i := 0; i < 2; i++ {
+		body, err = src()
+		require.NoError(t, err)
+		data, err = io.ReadAll(body)
+		require.NoError(t, err)
+		require.Equal(t, "bar", string(data))
+	}
+
+	// without io.ReaderAt the previous body stops reading once the reader is seeked again
+	src, reopenable = readerSource(struct{ io.ReadSeeker }{strings.NewReader("foobar")})
+	require.True(t, reopenable)
+	previous, err := src()
+	require.NoError(t, err)
+	body, err = src()
+	require.NoError(t, err)
+	_, err = previous.Read(make([]byte, 3))
+	require.ErrorIs(t, err, errBodyReopened)
+	data, err = io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "foobar", string(data))
+}
+
+func TestEncodedSource_JoinsPreviousBody(t *testing.T) {
+	src, _ := readerSource(struct{ io.ReadSeeker }{strings.NewReader(strings.Repeat("foobar", 1<<16))})
+	src = encodedSource(src, newEncoding(GzipEncoding, BestSpeed, EasyJSONCodec))
+
+	previous, err := src()
+	require.NoError(t, err)
+	_, err = previous.Read(make([]byte, 16))
+	require.NoError(t, err)
+
+	body, err := src()
+	require.NoError(t, err)
+	select {
+	case <-previous.(*pipeReader).done:
+	default:
+		t.Fatal("the goroutine of the previous body is still running")
+	}
+
+	gr, err := gzip.NewReader(body)
+	require.NoError(t, err)
+	data, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	require.Equal(t, strings.Repeat("foobar", 1<<16), string(data))
+}
+
+// uploadServer answers 503 to the first request and records the bodies it receives
+type uploadServer struct {
+	mu               sync.Mutex
+	bodies           []string
+	transferEncoding []string
+}
+
+func (s *uploadServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == GzipEncoding.String() {
+		gr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = gr
+	}
+	data, _ := io.ReadAll(body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bodies = append(s.bodies, string(data))
+	s.transferEncoding = append(s.transferEncoding, strings.Join(r.TransferEncoding, ","))
+	if len(s.bodies) == 1 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	resp := []byte(`{"taskUid":1,"status":"enqueued","type":"documentAdditionOrUpdate"}`)
+	if r.Header.Get("Accept-Encoding") == GzipEncoding.String() {
+		w.Header().Set("Content-Encoding", GzipEncoding.String())
+		w.WriteHeader(http.StatusAccepted)
+		gw := gzip.NewWriter(w)
+		_, _ = gw.Write(resp)
+		_ = gw.Close()
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+	_, _ = w.Write(resp)
+}
+
+func TestStreamedRequestBody(t *testing.T) {
+	fastRetries := func(sm ServiceManager) ServiceManager {
+		sm.(*meilisearch).client.retryBackoff = func(uint8) time.Duration { return time.Millisecond }
+		return sm
+	}
+	documents := []map[string]interface{}{{"id": 1, "title": "Carol"}, {"id": 2, "title": "Wonder Woman"}}
+
+	t.Run("documents are retried", func(t *testing.T) {
+		s := &uploadServer{}
+		ts := httptest.NewServer(s)
+		defer ts.Close()
+
+		_, err := fastRetries(New(ts.URL)).Index("movies").AddDocuments(documents)
+		require.NoError(t, err)
+		require.Equal(t, []string{`[{"id":1,"title":"Carol"},{"id":2,"title":"Wonder Woman"}]`,
+			`[{"id":1,"title":"Carol"},{"id":2,"title":"Wonder Woman"}]`}, s.bodies)
+		require.Equal(t, []string{"chunked", "chunked"}, s.transferEncoding)
+	})
+
+	t.Run("compressed documents", func(t *testing.T) {
+		s := &uploadServer{}
+		ts := httptest.NewServer(s)
+		defer ts.Close()
+
+		_, err := fastRetries(New(ts.URL, WithContentEncoding(GzipEncoding, DefaultCompression))).Index("movies").UpdateDocuments(documents)
+		require.NoError(t, err)
+		require.Len(t, s.bodies, 2)
+		require.Equal(t, s.bodies[0], s.bodies[1])
+		require.Equal(t, `[{"id":1,"title":"Carol"},{"id":2,"title":"Wonder Woman"}]`, s.bodies[1])
+	})
+
+	t.Run("file is retried", func(t *testing.T) {
+		s := &uploadServer{}
+		ts := httptest.NewServer(s)
+		defer ts.Close()
+
+		path := filepath.Join(t.TempDir(), "movies.ndjson")
+		require.NoError(t, os.WriteFile(path, []byte("{\"id\":1}\n{\"id\":2}\n"), 0o600))
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		_, err = fastRetries(New(ts.URL)).Index("movies").AddDocumentsNdjsonFromReader(f)
+		require.NoError(t, err)
+		require.Equal(t, []string{"{\"id\":1}\n{\"id\":2}\n", "{\"id\":1}\n{\"id\":2}\n"}, s.bodies)
+	})
+
+	t.Run("file is sent again after a 415 during the upload", func(t *testing.T) {
+		content := bytes.Repeat([]byte("{\"id\":1,\"title\":\"Carol\"}\n"), 1<<15)
+		var (
+			mu     sync.Mutex
+			bodies [][]byte
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Content-Encoding") != "" {
+				// answers before the whole body is uploaded
+				_, _ = io.ReadFull(r.Body, make([]byte, 512))
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				return
+			}
+			data, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			mu.Lock()
+			bodies = append(bodies, data)
+			mu.Unlock()
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":1}`))
+		}))
+		defer ts.Close()
+
+		path := filepath.Join(t.TempDir(), "movies.ndjson")
+		require.NoError(t, os.WriteFile(path, content, 0o600))
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+
+		sm := New(ts.URL, WithContentEncoding(GzipEncoding, BestSpeed))
+		_, err = sm.Index("movies").AddDocumentsNdjsonFromReader(f)
+		require.NoError(t, err)
+		require.Len(t, bodies, 1)
+		require.Equal(t, content, bodies[0])
+	})
+
+	t.Run("reader is not retried", func(t *testing.T) {
+		s := &uploadServer{}
+		ts := httptest.NewServer(s)
+		defer ts.Close()
+
+		_, err := fastRetries(New(ts.URL)).Index("movies").AddDocumentsCsvFromReader(io.MultiReader(strings.NewReader("id\n1\n")), nil)
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		require.Equal(t, []string{"id\n1\n"}, s.bodies)
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer ts.Close()
+
+		_, err := New(ts.URL).Index("movies").AddDocuments([]interface{}{map[string]interface{}{"id": 1}, make(chan int)})
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, ErrCodeMarshalRequest, apiErr.ErrCode)
+		var jsonErr *json.UnsupportedTypeError
+		require.True(t, errors.As(err, &jsonErr))
+	})
+
+	t.Run("in memory body keeps its length", func(t *testing.T) {
+		var contentLength int64
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			contentLength = r.ContentLength
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":1}`))
+		}))
+		defer ts.Close()
+
+		_, err := New(ts.URL).Index("movies").AddDocumentsNdjsonFromReader(bytes.NewBufferString("{\"id\":1}\n"))
+		require.NoError(t, err)
+		require.Equal(t, int64(9), contentLength)
+	})
+}
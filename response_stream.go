@@ -0,0 +1,124 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// executeResultsStream sends the request and decodes the response while it is read: fn is called
+// with every element of the array field, eg. `hits`, the other fields are decoded into meta.
+// The response is decompressed while it is read when the content encoding is enabled.
+func (c *client) executeResultsStream(ctx context.Context, req *internalRequest, field string, meta interface{}, fn func(json.RawMessage) error) error {
+	req.withResponse = nil
+	req.streamResponse = true
+	resp, err := c.executeStreamRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	internalError := c.newInternalError(req)
+	internalError.StatusCode = resp.StatusCode
+
+	body, err := decompressBody(resp)
+	if err != nil {
+		return internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	if err := decodeResultsStream(body, field, meta, fn, c.jsonCodec); err != nil {
+		if decodeErr, ok := err.(*streamDecodeError); ok {
+			return internalError.WithErrCode(ErrCodeResponseUnmarshalBody, decodeErr.err)
+		}
+		return err
+	}
+	return nil
+}
+
+// streamDecodeError is returned by decodeResultsStream when the response is not valid JSON, the
+// errors returned by the callback are returned as is
+type streamDecodeError struct {
+	err error
+}
+
+func (e *streamDecodeError) Error() string {
+	return e.err.Error()
+}
+
+func decodeResultsStream(r io.Reader, field string, meta interface{}, fn func(json.RawMessage) error, codec JSONCodec) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	fields := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return &streamDecodeError{err: err}
+		}
+		key, _ := tok.(string)
+		if key != field {
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return &streamDecodeError{err: err}
+			}
+			fields[key] = value
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return &streamDecodeError{err: err}
+		}
+		if tok == nil {
+			continue
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return &streamDecodeError{err: fmt.Errorf("expected an array for %q, got %v", field, tok)}
+		}
+		for dec.More() {
+			var element json.RawMessage
+			if err := dec.Decode(&element); err != nil {
+				return &streamDecodeError{err: err}
+			}
+			if err := fn(element); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return err
+	}
+
+	if meta == nil {
+		return nil
+	}
+	data, err := codec.Marshal(fields)
+	if err != nil {
+		return &streamDecodeError{err: err}
+	}
+	if err := codec.Unmarshal(data, meta); err != nil {
+		return &streamDecodeError{err: err}
+	}
+	return nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return &streamDecodeError{err: err}
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return &streamDecodeError{err: fmt.Errorf("expected %v, got %v", want, tok)}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package meilisearch
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeResultsStream(t *testing.T) {
+	collect := func(data string) ([]string, *SearchResponse, error) {
+		var hits []string
+		meta := new(SearchResponse)
+		err := decodeResultsStream(strings.NewReader(data), "hits", meta, func(hit json.RawMessage) error {
+			hits = append(hits, string(hit))
+			return nil
+		}, EasyJSONCodec)
+		return hits, meta, err
+	}
+
+	hits, meta, err := collect(`{"query":"carol","hits":[{"id":1},{"id":2,"_vectors":{"default":[0.1,0.2]}}],"processingTimeMs":2,` +
+		`"facetDistribution":{"genre":{"drama":2}}}`)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"id":1}`, `{"id":2,"_vectors":{"default":[0.1,0.2]}}`}, hits)
+	require.Equal(t, "carol", meta.Query)
+	require.Equal(t, int64(2), meta.ProcessingTimeMs)
+	require.Equal(t, int64(2), meta.FacetDistribution["genre"]["drama"])
+	require.Nil(t, meta.Hits)
+
+	hits, _, err = collect(`{"hits":null,"query":""}`)
+	require.NoError(t, err)
+	require.Empty(t, hits)
+
+	for _, data := range []string{`[]`, `{"hits":{}}`, `{"hits":[{"id":1}`, `{"hits":[],"query":1}`} {
+		_, _, err = collect(data)
+		var decodeErr *streamDecodeError
+		require.ErrorAs(t, err, &decodeErr, data)
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err = decodeResultsStream(strings.NewReader(`{"hits":[{"id":1},{"id":2}]}`), "hits", nil, func(json.RawMessage) error {
+		calls++
+		return stop
+	}, EasyJSONCodec)
+	require.ErrorIs(t, err, stop)
+	require.Equal(t, 1, calls)
+}
+
+func TestSearchStream(t *testing.T) {
+	response := `{"hits":[{"id":1,"title":"Carol"},{"id":2,"title":"Wonder Woman"}],"query":"a","processingTimeMs":1,"limit":20,"offset":0,"estimatedTotalHits":2}`
+
+	tests := []struct {
+		name     string
+		encoding ContentEncoding
+		compress func(data string) []byte
+	}{
+		{name: "identity"},
+		{name: "gzip", encoding: GzipEncoding, compress: func(data string) []byte {
+			buf := &bytes.Buffer{}
+			w := gzip.NewWriter(buf)
+			_, _ = w.Write([]byte(data))
+			_ = w.Close()
+			return buf.Bytes()
+		}},
+		{name: "brotli", encoding: BrotliEncoding, compress: func(data string) []byte {
+			buf := &bytes.Buffer{}
+			w := brotli.NewWriter(buf)
+			_, _ = w.Write([]byte(data))
+			_ = w.Close()
+			return buf.Bytes()
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.encoding.IsZero() {
+					_, _ = w.Write([]byte(response))
+					return
+				}
+				require.Equal(t, tt.encoding.String(), r.Header.Get("Accept-Encoding"))
+				w.Header().Set("Content-Encoding", tt.encoding.String())
+				_, _ = w.Write(tt.compress(response))
+			}))
+			defer ts.Close()
+
+			var options []Option
+			if !tt.encoding.IsZero() {
+				options = append(options, WithContentEncoding(tt.encoding, DefaultCompression))
+			}
+
+			type movie struct {
+				ID    int    `json:"id"`
+				Title string `json:"title"`
+			}
+			var movies []movie
+			resp, err := New(ts.URL, options...).Index("movies").SearchStream("a", &SearchRequest{}, func(hit json.RawMessage) error {
+				var m movie
+				if err := json.Unmarshal(hit, &m); err != nil {
+					return err
+				}
+				movies = append(movies, m)
+				return nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, []movie{{ID: 1, Title: "Carol"}, {ID: 2, Title: "Wonder Woman"}}, movies)
+			require.Equal(t, int64(2), resp.EstimatedTotalHits)
+			require.Equal(t, int64(20), resp.Limit)
+		})
+	}
+}
+
+func TestGetDocumentsStream(t *testing.T) {
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		switch r.URL.Path {
+		case "/indexes/movies/documents":
+			_, _ = w.Write([]byte(`{"results":[{"id":1},{"id":2}],"offset":0,"limit":2,"total":3}`))
+		case "/indexes/broken/documents":
+			_, _ = w.Write([]byte(`{"results":[{"id":1},`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index not found.","code":"index_not_found","type":"invalid_request","link":""}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := New(ts.URL)
+	var ids []string
+	resp, err := client.Index("movies").GetDocumentsStream(&DocumentsQuery{Limit: 2}, func(document json.RawMessage) error {
+		ids = append(ids, string(document))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "limit=2", query)
+	require.Equal(t, []string{`{"id":1}`, `{"id":2}`}, ids)
+	require.Equal(t, int64(3), resp.Total)
+	require.Nil(t, resp.Results)
+
+	_, err = client.Index("broken").GetDocumentsStream(nil, func(json.RawMessage) error { return nil })
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, ErrCodeResponseUnmarshalBody, apiErr.ErrCode)
+
+	_, err = client.Index("unknown").GetDocumentsStream(nil, func(json.RawMessage) error { return nil })
+	require.ErrorIs(t, err, APIErrorCodeIndexNotFound)
+	require.Contains(t, err.Error(), "Hint: It might not be working because you're not up to date with the Meilisearch version that GetDocuments call requires")
+
+	stop := errors.New("stop")
+	_, err = client.Index("movies").GetDocumentsStream(nil, func(json.RawMessage) error { return stop })
+	require.Equal(t, stop, err)
+}
@@ -0,0 +1,281 @@
+package meilisearch
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// IndexSchema is the index configuration derived from a Go type by SchemaFromType
+type IndexSchema struct {
+	// PrimaryKey is the attribute tagged `primary`, empty when no field is tagged
+	PrimaryKey string
+	// Settings holds the searchable, filterable, sortable, displayed and distinct attributes
+	Settings *Settings
+
+	primaryKeyIndex []int
+}
+
+// IndexConfig returns the configuration to give to CreateIndex for an index named uid
+func (s *IndexSchema) IndexConfig(uid string) *IndexConfig {
+	return &IndexConfig{
+		Uid:        uid,
+		PrimaryKey: s.PrimaryKey,
+	}
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	geoPointType      = reflect.TypeOf(GeoPoint{})
+)
+
+// SchemaFromType derives the index settings and primary key of a document type from its `meili`
+// struct tags. v is a value or a pointer of the document type, eg. SchemaFromType(Movie{}).
+//
+// Attribute names follow the `json` tag of the fields, the `meili` tag is a comma-separated list of:
+//
+//	primary      the field is the primary key of the index, at most one top-level field
+//	searchable   added to SearchableAttributes, in field order
+//	filterable   added to FilterableAttributes
+//	sortable     added to SortableAttributes
+//	displayed    added to DisplayedAttributes, all attributes are displayed when no field uses it
+//	distinct     the field is the DistinctAttribute, at most one field
+//
+// Fields of nested structs are named with dotted paths (`info.comment`), embedded structs are
+// flattened like encoding/json does, with the same rules when several fields have the same name.
+// Fields tagged `meili:"-"` or `json:"-"` are skipped, a recursive type is described down to its first
+// repetition.
+//
+//	type Movie struct {
+//		ID     string   `json:"id" meili:"primary"`
+//		Title  string   `json:"title" meili:"searchable,sortable"`
+//		Genres []string `json:"genres" meili:"searchable,filterable"`
+//		Info   struct {
+//			Year int `json:"year" meili:"filterable,sortable"`
+//		} `json:"info"`
+//	}
+func SchemaFromType(v interface{}) (*IndexSchema, error) {
+	if v == nil {
+		return nil, fmt.Errorf("%w: nil value", ErrInvalidSchema)
+	}
+	return schemaFromReflectType(reflect.TypeOf(v))
+}
+
+func schemaFromReflectType(t reflect.Type) (*IndexSchema, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s is not a struct", ErrInvalidSchema, t)
+	}
+
+	s := &IndexSchema{Settings: new(Settings)}
+	if err := s.walk(t, "", nil, map[reflect.Type]bool{}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// walk applies the tags of the fields of t, a type already being walked is not walked again so a
+// recursive type stops at its first repetition
+func (s *IndexSchema) walk(t reflect.Type, prefix string, index []int, visiting map[reflect.Type]bool) error {
+	if visiting[t] {
+		return nil
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	for _, f := range schemaFields(t) {
+		fieldIndex := append(append([]int{}, index...), f.index...)
+		path := prefix + f.name
+		if err := s.apply(path, f.field.Tag.Get("meili"), f.field, fieldIndex, prefix == ""); err != nil {
+			return err
+		}
+
+		ft := f.field.Type
+		for ft.Kind() == reflect.Ptr || ft.Kind() == reflect.Slice || ft.Kind() == reflect.Array {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && !isSchemaLeaf(ft) {
+			if err := s.walk(ft, path+".", fieldIndex, visiting); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// schemaField is a field of a struct with the fields of its embedded structs promoted
+type schemaField struct {
+	name   string
+	tagged bool
+	depth  int
+	index  []int
+	field  reflect.StructField
+}
+
+// schemaFields returns the fields of t encoded by encoding/json, in field order. The fields of the
+// embedded structs without a json name are promoted, a name used by several fields goes to the
+// shallowest one, or to the only one with a json name at that depth, the fields cancel out otherwise.
+func schemaFields(t reflect.Type) []schemaField {
+	type embedded struct {
+		typ   reflect.Type
+		index []int
+	}
+
+	var fields []schemaField
+	expanded := map[reflect.Type]bool{}
+	next := []embedded{{typ: t}}
+	for depth := 0; len(next) > 0; depth++ {
+		current := next
+		next = nil
+		for _, e := range current {
+			if expanded[e.typ] {
+				continue
+			}
+			expanded[e.typ] = true
+
+			for i := 0; i < e.typ.NumField(); i++ {
+				f := e.typ.Field(i)
+				if f.PkgPath != "" && !f.Anonymous {
+					// unexported field
+					continue
+				}
+
+				name, tagged, skip := jsonFieldName(f)
+				if skip || f.Tag.Get("meili") == "-" {
+					continue
+				}
+
+				index := append(append([]int{}, e.index...), i)
+				ft := f.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if f.Anonymous && !tagged && ft.Kind() == reflect.Struct {
+					next = append(next, embedded{typ: ft, index: index})
+					continue
+				}
+				if f.PkgPath != "" {
+					continue
+				}
+				fields = append(fields, schemaField{name: name, tagged: tagged, depth: depth, index: index, field: f})
+			}
+		}
+	}
+
+	byName := map[string][]schemaField{}
+	for _, f := range fields {
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	dominant := fields[:0:0]
+	for _, f := range fields {
+		if d, ok := dominantField(byName[f.name]); ok && reflect.DeepEqual(d.index, f.index) {
+			dominant = append(dominant, f)
+		}
+	}
+	sort.Slice(dominant, func(i, j int) bool {
+		a, b := dominant[i].index, dominant[j].index
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return dominant
+}
+
+// dominantField returns the field encoded under a name used by fields, fields are ordered by depth
+func dominantField(fields []schemaField) (schemaField, bool) {
+	var candidates []schemaField
+	for _, f := range fields {
+		if f.depth == fields[0].depth {
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+
+	var tagged []schemaField
+	for _, f := range candidates {
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return schemaField{}, false
+}
+
+func (s *IndexSchema) apply(path, tag string, f reflect.StructField, index []int, topLevel bool) error {
+	if tag == "" {
+		return nil
+	}
+
+	for _, opt := range strings.Split(tag, ",") {
+		switch strings.TrimSpace(opt) {
+		case "":
+		case "primary":
+			if !topLevel {
+				return fmt.Errorf("%w: primary key %q must be a top-level field", ErrInvalidSchema, path)
+			}
+			if s.PrimaryKey != "" {
+				return fmt.Errorf("%w: both %q and %q are tagged as primary key", ErrInvalidSchema, s.PrimaryKey, path)
+			}
+			s.PrimaryKey = path
+			s.primaryKeyIndex = index
+		case "searchable":
+			s.Settings.SearchableAttributes = append(s.Settings.SearchableAttributes, path)
+		case "filterable":
+			s.Settings.FilterableAttributes = append(s.Settings.FilterableAttributes, path)
+		case "sortable":
+			s.Settings.SortableAttributes = append(s.Settings.SortableAttributes, path)
+		case "displayed":
+			s.Settings.DisplayedAttributes = append(s.Settings.DisplayedAttributes, path)
+		case "distinct":
+			if s.Settings.DistinctAttribute != nil {
+				return fmt.Errorf("%w: both %q and %q are tagged as distinct attribute",
+					ErrInvalidSchema, *s.Settings.DistinctAttribute, path)
+			}
+			distinct := path
+			s.Settings.DistinctAttribute = &distinct
+		default:
+			return fmt.Errorf("%w: unknown option %q on field %s", ErrInvalidSchema, opt, f.Name)
+		}
+	}
+	return nil
+}
+
+// jsonFieldName returns the attribute name of a field as encoding/json would name it, tagged reports
+// whether the name comes from the json tag
+func jsonFieldName(f reflect.StructField) (name string, tagged, skip bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	if idx := strings.Index(tag, ","); idx >= 0 {
+		tag = tag[:idx]
+	}
+	if tag == "" {
+		return f.Name, false, false
+	}
+	return tag, true, false
+}
+
+// isSchemaLeaf reports whether a struct type is encoded as a single value and must not be walked
+func isSchemaLeaf(t reflect.Type) bool {
+	if t == geoPointType {
+		return true
+	}
+	pt := reflect.PtrTo(t)
+	return t.Implements(jsonMarshalerType) || pt.Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) || pt.Implements(textMarshalerType)
+}
@@ -0,0 +1,128 @@
+package meilisearch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type schemaTestBase struct {
+	CreatedAt time.Time `json:"createdAt" meili:"sortable,filterable"`
+}
+
+type schemaTestMovie struct {
+	schemaTestBase
+	ID       string    `json:"id" meili:"primary"`
+	Title    string    `json:"title" meili:"searchable,sortable,displayed"`
+	Overview string    `json:"overview,omitempty" meili:"searchable,displayed"`
+	Genres   []string  `json:"genres" meili:"filterable"`
+	Geo      *GeoPoint `json:"_geo,omitempty" meili:"filterable,sortable"`
+	Info     *struct {
+		Director string `json:"director" meili:"searchable"`
+		Year     int    `json:"year" meili:"filterable,sortable"`
+	} `json:"info"`
+	Cast []struct {
+		Name string `json:"name" meili:"searchable"`
+	} `json:"cast"`
+	Slug     string `json:"slug" meili:"distinct"`
+	Internal string `json:"-" meili:"searchable"`
+	Ignored  string `json:"ignored" meili:"-"`
+	NoTag    string
+}
+
+func TestSchemaFromType(t *testing.T) {
+	schema, err := SchemaFromType(&schemaTestMovie{})
+	require.NoError(t, err)
+
+	slug := "slug"
+	require.Equal(t, "id", schema.PrimaryKey)
+	require.Equal(t, &Settings{
+		SearchableAttributes: []string{"title", "overview", "info.director", "cast.name"},
+		FilterableAttributes: []string{"createdAt", "genres", "_geo", "info.year"},
+		SortableAttributes:   []string{"createdAt", "title", "_geo", "info.year"},
+		DisplayedAttributes:  []string{"title", "overview"},
+		DistinctAttribute:    &slug,
+	}, schema.Settings)
+
+	require.Equal(t, &IndexConfig{Uid: "movies", PrimaryKey: "id"}, schema.IndexConfig("movies"))
+}
+
+type schemaTestNode struct {
+	Name     string            `json:"name" meili:"searchable"`
+	Children []schemaTestNode  `json:"children"`
+	Parent   *schemaTestNode   `json:"parent"`
+	Tags     map[string]string `json:"tags"`
+}
+
+func TestSchemaFromType_Recursive(t *testing.T) {
+	schema, err := SchemaFromType(schemaTestNode{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"name"}, schema.Settings.SearchableAttributes)
+}
+
+type schemaTestTitled struct {
+	Title string `json:"title" meili:"searchable"`
+	Rank  int    `meili:"filterable"`
+}
+
+type schemaTestNamed struct {
+	Name string `json:"Name" meili:"searchable"`
+	Rank int    `meili:"sortable"`
+}
+
+type schemaTestLabel struct {
+	Name  string `meili:"filterable"`
+	Label string `json:"label" meili:"displayed"`
+}
+
+func TestSchemaFromType_Shadowing(t *testing.T) {
+	schema, err := SchemaFromType(struct {
+		schemaTestTitled
+		schemaTestNamed
+		schemaTestLabel
+		Title string `json:"title" meili:"sortable"`
+	}{})
+	require.NoError(t, err)
+
+	// the top-level title shadows the embedded one, the two ranks at the same depth cancel out and
+	// the name with a json tag wins at the same depth
+	require.Equal(t, &Settings{
+		SearchableAttributes: []string{"Name"},
+		SortableAttributes:   []string{"title"},
+		DisplayedAttributes:  []string{"label"},
+	}, schema.Settings)
+}
+
+func TestSchemaFromType_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+	}{
+		{name: "nil", v: nil},
+		{name: "not a struct", v: []string{}},
+		{name: "two primary keys", v: struct {
+			A string `meili:"primary"`
+			B string `meili:"primary"`
+		}{}},
+		{name: "nested primary key", v: struct {
+			A struct {
+				B string `meili:"primary"`
+			}
+		}{}},
+		{name: "two distinct attributes", v: struct {
+			A string `meili:"distinct"`
+			B string `meili:"distinct"`
+		}{}},
+		{name: "unknown option", v: struct {
+			A string `meili:"searchable,fuzzy"`
+		}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaFromType(tt.v)
+			require.ErrorIs(t, err, ErrInvalidSchema)
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Task indicates information about a task resource
+//
+// Documentation: https://www.meilisearch.com/docs/learn/advanced/asynchronous_operations
+type Task struct {
+	Status     TaskStatus    `json:"status"`
+	UID        int64         `json:"uid,omitempty"`
+	TaskUID    int64         `json:"taskUid,omitempty"`
+	IndexUID   string        `json:"indexUid"`
+	Type       TaskType      `json:"type"`
+	Error      TaskError     `json:"error,omitempty"`
+	Duration   time.Duration `json:"duration,omitempty"`
+	EnqueuedAt time.Time     `json:"enqueuedAt"`
+	StartedAt  time.Time     `json:"startedAt,omitempty"`
+	FinishedAt time.Time     `json:"finishedAt,omitempty"`
+	// Details depends on Type, eg. *DocumentAdditionDetails for TaskTypeDocumentAdditionOrUpdate,
+	// it is nil when the task has no details
+	Details    TaskDetails `json:"details,omitempty"`
+	CanceledBy int64       `json:"canceledBy,omitempty"`
+	// BatchUID is the uid of the batch processing the task, nil while it is enqueued
+	BatchUID *int64 `json:"batchUid,omitempty"`
+}
+
+// TaskError is the error of a failed task
+//
+// Documentation: https://www.meilisearch.com/docs/reference/errors/overview
+type TaskError struct {
+	Message string       `json:"message"`
+	Code    APIErrorCode `json:"code"`
+	Type    APIErrorType `json:"type"`
+	Link    string       `json:"link"`
+}
+
+// Error implements the error interface
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.Message, e.Code)
+}
+
+// Is reports whether the task error has the code target, eg. errors.Is(task.Err(), APIErrorCodeIndexNotFound)
+func (e *TaskError) Is(target error) bool {
+	code, ok := target.(APIErrorCode)
+	return ok && e.Code == code
+}
+
+// Err returns the error of the task, nil when it did not fail
+func (t *Task) Err() error {
+	if t.Error.Code == "" && t.Error.Message == "" {
+		return nil
+	}
+	return &t.Error
+}
+
+// taskJSON is the wire format of a Task
+type taskJSON struct {
+	Status     TaskStatus      `json:"status"`
+	UID        int64           `json:"uid,omitempty"`
+	TaskUID    int64           `json:"taskUid,omitempty"`
+	IndexUID   string          `json:"indexUid"`
+	Type       TaskType        `json:"type"`
+	Error      *TaskError      `json:"error,omitempty"`
+	Duration   string          `json:"duration,omitempty"`
+	EnqueuedAt time.Time       `json:"enqueuedAt"`
+	StartedAt  time.Time       `json:"startedAt,omitempty"`
+	FinishedAt time.Time       `json:"finishedAt,omitempty"`
+	Details    json.RawMessage `json:"details,omitempty"`
+	CanceledBy int64           `json:"canceledBy,omitempty"`
+	BatchUID   *int64          `json:"batchUid,omitempty"`
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface, the details are decoded in the type matching
+// the task type
+func (t *Task) UnmarshalJSON(data []byte) error {
+	var raw taskJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	duration, err := parseISO8601Duration(raw.Duration)
+	if err != nil {
+		return err
+	}
+	details, err := decodeTaskDetails(raw.Type, raw.Details)
+	if err != nil {
+		return err
+	}
+
+	*t = Task{
+		Status:     raw.Status,
+		UID:        raw.UID,
+		TaskUID:    raw.TaskUID,
+		IndexUID:   raw.IndexUID,
+		Type:       raw.Type,
+		Duration:   duration,
+		EnqueuedAt: raw.EnqueuedAt,
+		StartedAt:  raw.StartedAt,
+		FinishedAt: raw.FinishedAt,
+		Details:    details,
+		CanceledBy: raw.CanceledBy,
+		BatchUID:   raw.BatchUID,
+	}
+	if raw.Error != nil {
+		t.Error = *raw.Error
+	}
+	return nil
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (t Task) MarshalJSON() ([]byte, error) {
+	raw := taskJSON{
+		Status:     t.Status,
+		UID:        t.UID,
+		TaskUID:    t.TaskUID,
+		IndexUID:   t.IndexUID,
+		Type:       t.Type,
+		Duration:   formatISO8601Duration(t.Duration),
+		EnqueuedAt: t.EnqueuedAt,
+		StartedAt:  t.StartedAt,
+		FinishedAt: t.FinishedAt,
+		CanceledBy: t.CanceledBy,
+		BatchUID:   t.BatchUID,
+	}
+	if t.Err() != nil {
+		raw.Error = &t.Error
+	}
+	if t.Details != nil {
+		details, err := json.Marshal(t.Details)
+		if err != nil {
+			return nil, err
+		}
+		raw.Details = details
+	}
+	return json.Marshal(raw)
+}
+
+// TaskDetails are the details of a task, their type depends on the task type:
+//   - TaskTypeDocumentAdditionOrUpdate: *DocumentAdditionDetails
+//   - TaskTypeDocumentDeletion: *DocumentDeletionDetails
+//   - TaskTypeDocumentEdition: *DocumentEditionDetails
+//   - TaskTypeIndexCreation: *IndexCreationDetails
+//   - TaskTypeIndexUpdate: *IndexUpdateDetails
+//   - TaskTypeIndexDeletion: *IndexDeletionDetails
+//   - TaskTypeIndexSwap: *IndexSwapDetails
+//   - TaskTypeSettingsUpdate: *SettingsUpdateDetails
+//   - TaskTypeDumpCreation: *DumpCreationDetails
+//   - TaskTypeTaskCancelation: *TaskCancelationDetails
+//   - TaskTypeTaskDeletion: *TaskDeletionDetails
+//
+// The details of the other task types are a RawTaskDetails.
+type TaskDetails interface {
+	taskDetails()
+}
+
+// DocumentAdditionDetails are the details of a documentAdditionOrUpdate task
+type DocumentAdditionDetails struct {
+	ReceivedDocuments int64 `json:"receivedDocuments"`
+	IndexedDocuments  int64 `json:"indexedDocuments"`
+}
+
+// DocumentDeletionDetails are the details of a documentDeletion task
+type DocumentDeletionDetails struct {
+	ProvidedIds      int64  `json:"providedIds,omitempty"`
+	OriginalFilter   string `json:"originalFilter,omitempty"`
+	DeletedDocuments int64  `json:"deletedDocuments"`
+}
+
+// DocumentEditionDetails are the details of a documentEdition task
+type DocumentEditionDetails struct {
+	Function         string                 `json:"function"`
+	Context          map[string]interface{} `json:"context,omitempty"`
+	OriginalFilter   string                 `json:"originalFilter,omitempty"`
+	EditedDocuments  int64                  `json:"editedDocuments"`
+	DeletedDocuments int64                  `json:"deletedDocuments"`
+}
+
+// IndexCreationDetails are the details of an indexCreation task
+type IndexCreationDetails struct {
+	PrimaryKey string `json:"primaryKey,omitempty"`
+}
+
+// IndexUpdateDetails are the details of an indexUpdate task
+type IndexUpdateDetails struct {
+	PrimaryKey string `json:"primaryKey,omitempty"`
+}
+
+// IndexDeletionDetails are the details of an indexDeletion task
+type IndexDeletionDetails struct {
+	DeletedDocuments int64 `json:"deletedDocuments"`
+}
+
+// IndexSwapDetails are the details of an indexSwap task
+type IndexSwapDetails struct {
+	Swaps []SwapIndexesParams `json:"swaps"`
+}
+
+// SettingsUpdateDetails are the details of a settingsUpdate task, the settings sent by the update
+type SettingsUpdateDetails struct {
+	Settings
+}
+
+// DumpCreationDetails are the details of a dumpCreation task
+type DumpCreationDetails struct {
+	DumpUID string `json:"dumpUid"`
+}
+
+// TaskCancelationDetails are the details of a taskCancelation task
+type TaskCancelationDetails struct {
+	MatchedTasks   int64  `json:"matchedTasks"`
+	CanceledTasks  int64  `json:"canceledTasks"`
+	OriginalFilter string `json:"originalFilter"`
+}
+
+// TaskDeletionDetails are the details of a taskDeletion task
+type TaskDeletionDetails struct {
+	MatchedTasks   int64  `json:"matchedTasks"`
+	DeletedTasks   int64  `json:"deletedTasks"`
+	OriginalFilter string `json:"originalFilter"`
+}
+
+// RawTaskDetails are the details of the task types without a dedicated type
+type RawTaskDetails map[string]interface{}
+
+func (*DocumentAdditionDetails) taskDetails() {}
+func (*DocumentDeletionDetails) taskDetails() {}
+func (*DocumentEditionDetails) taskDetails()  {}
+func (*IndexCreationDetails) taskDetails()    {}
+func (*IndexUpdateDetails) taskDetails()      {}
+func (*IndexDeletionDetails) taskDetails()    {}
+func (*IndexSwapDetails) taskDetails()        {}
+func (*SettingsUpdateDetails) taskDetails()   {}
+func (*DumpCreationDetails) taskDetails()     {}
+func (*TaskCancelationDetails) taskDetails()  {}
+func (*TaskDeletionDetails) taskDetails()     {}
+func (RawTaskDetails) taskDetails()           {}
+
+func decodeTaskDetails(taskType TaskType, data json.RawMessage) (TaskDetails, error) {
+	if len(data) == 0 || string(data) == nullBody {
+		return nil, nil
+	}
+
+	var details TaskDetails
+	switch taskType {
+	case TaskTypeDocumentAdditionOrUpdate:
+		details = new(DocumentAdditionDetails)
+	case TaskTypeDocumentDeletion:
+		details = new(DocumentDeletionDetails)
+	case TaskTypeDocumentEdition:
+		details = new(DocumentEditionDetails)
+	case TaskTypeIndexCreation:
+		details = new(IndexCreationDetails)
+	case TaskTypeIndexUpdate:
+		details = new(IndexUpdateDetails)
+	case TaskTypeIndexDeletion:
+		details = new(IndexDeletionDetails)
+	case TaskTypeIndexSwap:
+		details = new(IndexSwapDetails)
+	case TaskTypeSettingsUpdate:
+		details = new(SettingsUpdateDetails)
+	case TaskTypeDumpCreation:
+		details = new(DumpCreationDetails)
+	case TaskTypeTaskCancelation:
+		details = new(TaskCancelationDetails)
+	case TaskTypeTaskDeletion:
+		details = new(TaskDeletionDetails)
+	default:
+		raw := RawTaskDetails{}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+		return raw, nil
+	}
+
+	if err := json.Unmarshal(data, details); err != nil {
+		return nil, fmt.Errorf("task details of %s: %w", taskType, err)
+	}
+	return details, nil
+}
+
+// parseISO8601Duration parses the durations of the tasks, eg. `PT0.0127S` or `P1DT2H3M`. The
+// years and months have no fixed length and are refused.
+func parseISO8601Duration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok || rest == "" || strings.HasSuffix(rest, "T") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+	}
+
+	var d time.Duration
+	inTime := false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if i <= 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		value, err := strconv.ParseFloat(strings.Replace(rest[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+
+		var unit time.Duration
+		switch {
+		case !inTime && rest[i] == 'W':
+			unit = 7 * 24 * time.Hour
+		case !inTime && rest[i] == 'D':
+			unit = 24 * time.Hour
+		case inTime && rest[i] == 'H':
+			unit = time.Hour
+		case inTime && rest[i] == 'M':
+			unit = time.Minute
+		case inTime && rest[i] == 'S':
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		d += time.Duration(value * float64(unit))
+		rest = rest[i+1:]
+	}
+	return d, nil
+}
+
+// formatISO8601Duration formats d in seconds like meilisearch, eg. `PT1.5S`
+func formatISO8601Duration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return "PT" + strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S"
+}
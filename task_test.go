@@ -0,0 +1,150 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		details TaskDetails
+	}{
+		{
+			name:    "documentAdditionOrUpdate",
+			data:    `{"type":"documentAdditionOrUpdate","details":{"receivedDocuments":3,"indexedDocuments":2}}`,
+			details: &DocumentAdditionDetails{ReceivedDocuments: 3, IndexedDocuments: 2},
+		},
+		{
+			name:    "documentDeletion",
+			data:    `{"type":"documentDeletion","details":{"providedIds":0,"originalFilter":"genre = horror","deletedDocuments":4}}`,
+			details: &DocumentDeletionDetails{OriginalFilter: "genre = horror", DeletedDocuments: 4},
+		},
+		{
+			name: "documentEdition",
+			data: `{"type":"documentEdition","details":{"function":"doc.title = title","context":{"title":"x"},` +
+				`"originalFilter":null,"editedDocuments":5,"deletedDocuments":1}}`,
+			details: &DocumentEditionDetails{Function: "doc.title = title", Context: map[string]interface{}{"title": "x"}, EditedDocuments: 5, DeletedDocuments: 1},
+		},
+		{
+			name:    "indexCreation",
+			data:    `{"type":"indexCreation","details":{"primaryKey":"id"}}`,
+			details: &IndexCreationDetails{PrimaryKey: "id"},
+		},
+		{
+			name:    "indexUpdate",
+			data:    `{"type":"indexUpdate","details":{"primaryKey":"uid"}}`,
+			details: &IndexUpdateDetails{PrimaryKey: "uid"},
+		},
+		{
+			name:    "indexDeletion",
+			data:    `{"type":"indexDeletion","details":{"deletedDocuments":10}}`,
+			details: &IndexDeletionDetails{DeletedDocuments: 10},
+		},
+		{
+			name:    "indexSwap",
+			data:    `{"type":"indexSwap","details":{"swaps":[{"indexes":["a","b"]}]}}`,
+			details: &IndexSwapDetails{Swaps: []SwapIndexesParams{{Indexes: []string{"a", "b"}}}},
+		},
+		{
+			name: "settingsUpdate",
+			data: `{"type":"settingsUpdate","details":{"filterableAttributes":["genre"],` +
+				`"localizedAttributes":[{"attributePatterns":["*_fr"],"locales":["fra"]}],"embedders":{"default":{"source":"userProvided","dimensions":3}}}}`,
+			details: &SettingsUpdateDetails{Settings: Settings{
+				FilterableAttributes: []string{"genre"},
+				LocalizedAttributes:  []*LocalizedAttributes{{AttributePatterns: []string{"*_fr"}, Locales: []string{"fra"}}},
+				Embedders:            map[string]Embedder{"default": {Source: "userProvided", Dimensions: 3}},
+			}},
+		},
+		{
+			name:    "dumpCreation",
+			data:    `{"type":"dumpCreation","details":{"dumpUid":"20240101-000000000"}}`,
+			details: &DumpCreationDetails{DumpUID: "20240101-000000000"},
+		},
+		{
+			name:    "taskCancelation",
+			data:    `{"type":"taskCancelation","details":{"matchedTasks":2,"canceledTasks":1,"originalFilter":"?uids=1,2"}}`,
+			details: &TaskCancelationDetails{MatchedTasks: 2, CanceledTasks: 1, OriginalFilter: "?uids=1,2"},
+		},
+		{
+			name:    "taskDeletion",
+			data:    `{"type":"taskDeletion","details":{"matchedTasks":2,"deletedTasks":2,"originalFilter":"?uids=1,2"}}`,
+			details: &TaskDeletionDetails{MatchedTasks: 2, DeletedTasks: 2, OriginalFilter: "?uids=1,2"},
+		},
+		{
+			name:    "snapshotCreation",
+			data:    `{"type":"snapshotCreation","details":null}`,
+			details: nil,
+		},
+		{
+			name:    "unknown",
+			data:    `{"type":"upgradeDatabase","details":{"upgradeFrom":"v1.12.0"}}`,
+			details: RawTaskDetails{"upgradeFrom": "v1.12.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var task Task
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &task))
+			require.Equal(t, tt.details, task.Details)
+
+			// the details survive a round trip
+			data, err := json.Marshal(task)
+			require.NoError(t, err)
+			var decoded Task
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			require.Equal(t, task, decoded)
+		})
+	}
+}
+
+func TestTask_ErrorAndDuration(t *testing.T) {
+	var result TaskResult
+	require.NoError(t, json.Unmarshal([]byte(`{"results":[{"uid":1,"status":"failed","type":"indexCreation","duration":"PT0.004S",`+
+		`"error":{"message":"Index already exists.","code":"index_already_exists","type":"invalid_request","link":"https://docs.meilisearch.com/errors#index_already_exists"}},`+
+		`{"uid":0,"status":"succeeded","type":"indexCreation","duration":"PT1M2.5S","error":null}],"limit":2,"from":1,"next":null,"total":2}`), &result))
+
+	failed := result.Results[0]
+	require.Equal(t, 4*time.Millisecond, failed.Duration)
+	require.Equal(t, APIErrorCodeIndexAlreadyExists, failed.Error.Code)
+	var taskErr *TaskError
+	require.ErrorAs(t, failed.Err(), &taskErr)
+	require.Equal(t, "Index already exists. (index_already_exists)", taskErr.Error())
+
+	succeeded := result.Results[1]
+	require.Equal(t, time.Minute+2500*time.Millisecond, succeeded.Duration)
+	require.NoError(t, succeeded.Err())
+
+	data, err := json.Marshal(succeeded)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), `"error"`)
+	require.Contains(t, string(data), `"duration":"PT62.5S"`)
+}
+
+func TestParseISO8601Duration(t *testing.T) {
+	valid := map[string]time.Duration{
+		"":            0,
+		"PT0.0127S":   12700 * time.Microsecond,
+		"PT2H3M4S":    2*time.Hour + 3*time.Minute + 4*time.Second,
+		"P1DT1H":      25 * time.Hour,
+		"P1W":         7 * 24 * time.Hour,
+		"PT0,5S":      500 * time.Millisecond,
+		"P2D":         48 * time.Hour,
+		"PT1.5M":      90 * time.Second,
+		"PT0.000001S": time.Microsecond,
+	}
+	for s, want := range valid {
+		d, err := parseISO8601Duration(s)
+		require.NoError(t, err, s)
+		require.Equal(t, want, d, s)
+	}
+
+	for _, s := range []string{"P", "1S", "PT", "P1Y", "P1M", "PTS", "PT1", "P1H", "PT1D", "PT1HT2M", "PT1.2.3S"} {
+		_, err := parseISO8601Duration(s)
+		require.ErrorIs(t, err, ErrInvalidDuration, s)
+	}
+}
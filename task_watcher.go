@@ -0,0 +1,426 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultTaskWatcherInterval is the time between two polls of a TaskWatcher
+const DefaultTaskWatcherInterval = time.Second
+
+// TaskEvent is emitted by a TaskWatcher when a task reaches Status: enqueued, processing or one of
+// the finished statuses succeeded, failed and canceled
+type TaskEvent struct {
+	Status TaskStatus
+	Task   Task
+}
+
+// Finished reports whether the event is emitted for a task which will not change anymore
+func (e TaskEvent) Finished() bool {
+	return e.Status == TaskStatusSucceeded || e.Status == TaskStatusFailed || e.Status == TaskStatusCanceled
+}
+
+// TaskEventHandler handles the events of a TaskWatcher, an error stops the watcher before the event
+// is committed to its cursor
+type TaskEventHandler func(ctx context.Context, event TaskEvent) error
+
+// TaskWatcherCursor is the position of a TaskWatcher in the tasks, persist it to resume watching
+// without missing or repeating events after a restart. The zero cursor starts at the first task.
+type TaskWatcherCursor struct {
+	// NextUID is the uid of the first task without an enqueued event
+	NextUID int64 `json:"nextUid"`
+	// FinishedAt is the finish date of the last task with a finished event
+	FinishedAt time.Time `json:"finishedAt"`
+	// FinishedUIDs are the tasks finished at FinishedAt with a finished event
+	FinishedUIDs []int64 `json:"finishedUids,omitempty"`
+	// Processing are the tasks with a processing event but no finished event yet
+	Processing []int64 `json:"processing,omitempty"`
+}
+
+func (c *TaskWatcherCursor) clone() *TaskWatcherCursor {
+	cp := *c
+	cp.FinishedUIDs = slices.Clone(c.FinishedUIDs)
+	cp.Processing = slices.Clone(c.Processing)
+	return &cp
+}
+
+// advance moves the cursor after event
+func (c *TaskWatcherCursor) advance(event TaskEvent) {
+	uid := event.Task.UID
+	switch {
+	case event.Status == TaskStatusEnqueued:
+		c.NextUID = uid + 1
+	case event.Status == TaskStatusProcessing:
+		if !slices.Contains(c.Processing, uid) {
+			c.Processing = append(c.Processing, uid)
+		}
+	case event.Finished():
+		if event.Task.FinishedAt.Equal(c.FinishedAt) {
+			c.FinishedUIDs = append(c.FinishedUIDs, uid)
+		} else {
+			c.FinishedAt = event.Task.FinishedAt
+			c.FinishedUIDs = []int64{uid}
+		}
+		c.Processing = slices.DeleteFunc(c.Processing, func(p int64) bool { return p == uid })
+	}
+}
+
+// TaskCursorStore persists the cursor of a TaskWatcher
+type TaskCursorStore interface {
+	// LoadCursor returns the saved cursor, nil when there is none
+	LoadCursor(ctx context.Context) (*TaskWatcherCursor, error)
+	// SaveCursor replaces the saved cursor
+	SaveCursor(ctx context.Context, cursor *TaskWatcherCursor) error
+}
+
+type fileCursorStore struct {
+	path string
+}
+
+// NewFileCursorStore returns a TaskCursorStore saving the cursor as JSON in the file at path
+func NewFileCursorStore(path string) TaskCursorStore {
+	return &fileCursorStore{path: path}
+}
+
+func (s *fileCursorStore) LoadCursor(_ context.Context) (*TaskWatcherCursor, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cursor := new(TaskWatcherCursor)
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+func (s *fileCursorStore) SaveCursor(_ context.Context, cursor *TaskWatcherCursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	// the cursor is written next to the file then renamed so a crash never leaves half a cursor
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// TaskWatcherOptions configures a TaskWatcher
+type TaskWatcherOptions struct {
+	// Query filters the watched tasks with its IndexUIDS, Types, UIDS and CanceledBy, Statuses
+	// filters the emitted events and Limit is the number of tasks fetched per request
+	Query *TasksQuery
+	// Interval is the time between two polls, DefaultTaskWatcherInterval when zero
+	Interval time.Duration
+	// Store persists the cursor after every poll and is read when the watcher starts
+	Store TaskCursorStore
+	// Cursor is the starting position when Store has no cursor, when both are nil the watcher
+	// starts after the latest task
+	Cursor *TaskWatcherCursor
+	// OnError is called when a poll fails and the watcher keeps running, Run returns the error
+	// when it is nil
+	OnError func(err error)
+}
+
+type taskHandler struct {
+	types   []TaskType
+	handler TaskEventHandler
+}
+
+// TaskWatcher tails /tasks and emits an event every time a matching task is enqueued, starts
+// processing or finishes. Processing events are only emitted for the tasks seen processing by
+// a poll.
+type TaskWatcher struct {
+	client  TaskReader
+	options TaskWatcherOptions
+
+	mu       sync.Mutex
+	cursor   *TaskWatcherCursor
+	handlers []taskHandler
+}
+
+// NewTaskWatcher returns a TaskWatcher polling the tasks of client, a ServiceManager or the
+// TaskReader of an index to only watch its tasks
+func NewTaskWatcher(client TaskReader, options *TaskWatcherOptions) *TaskWatcher {
+	w := &TaskWatcher{client: client}
+	if options != nil {
+		w.options = *options
+	}
+	if w.options.Interval <= 0 {
+		w.options.Interval = DefaultTaskWatcherInterval
+	}
+	return w
+}
+
+// Handle registers handler for the events of the tasks of the given types, of every task when
+// no type is given. The handlers are called in registration order.
+func (w *TaskWatcher) Handle(handler TaskEventHandler, types ...TaskType) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.handlers = append(w.handlers, taskHandler{types: types, handler: handler})
+}
+
+// Cursor returns the position of the watcher, nil before it starts
+func (w *TaskWatcher) Cursor() *TaskWatcherCursor {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cursor == nil {
+		return nil
+	}
+	return w.cursor.clone()
+}
+
+// Run polls the tasks every interval and calls the handlers with the events until ctx is done or
+// a handler fails. The cursor is saved after every poll, and before returning a handler error so
+// the failed event is emitted again by the next run.
+func (w *TaskWatcher) Run(ctx context.Context) error {
+	if err := w.start(ctx); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(w.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var handlerErr *taskHandlerError
+			if errors.As(err, &handlerErr) || w.options.OnError == nil {
+				return err
+			}
+			w.options.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll fetches the tasks changed since the cursor once and calls the handlers with the events
+func (w *TaskWatcher) Poll(ctx context.Context) error {
+	if err := w.start(ctx); err != nil {
+		return err
+	}
+
+	events, err := w.events(ctx, w.Cursor())
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if err := w.dispatch(ctx, event); err != nil {
+			if saveErr := w.save(ctx); saveErr != nil {
+				return errors.Join(err, saveErr)
+			}
+			return err
+		}
+		w.mu.Lock()
+		w.cursor.advance(event)
+		w.mu.Unlock()
+	}
+	return w.save(ctx)
+}
+
+type taskHandlerError struct {
+	err error
+}
+
+func (e *taskHandlerError) Error() string { return e.err.Error() }
+func (e *taskHandlerError) Unwrap() error { return e.err }
+
+func (w *TaskWatcher) dispatch(ctx context.Context, event TaskEvent) error {
+	if w.options.Query != nil && len(w.options.Query.Statuses) != 0 && !slices.Contains(w.options.Query.Statuses, event.Status) {
+		return nil
+	}
+
+	w.mu.Lock()
+	handlers := slices.Clone(w.handlers)
+	w.mu.Unlock()
+
+	for _, h := range handlers {
+		if len(h.types) != 0 && !slices.Contains(h.types, event.Task.Type) {
+			continue
+		}
+		if err := h.handler(ctx, event); err != nil {
+			return &taskHandlerError{err: err}
+		}
+	}
+	return nil
+}
+
+func (w *TaskWatcher) save(ctx context.Context) error {
+	if w.options.Store == nil {
+		return nil
+	}
+	return w.options.Store.SaveCursor(ctx, w.Cursor())
+}
+
+// start sets the cursor from the store, the options or the latest task
+func (w *TaskWatcher) start(ctx context.Context) error {
+	if w.Cursor() != nil {
+		return nil
+	}
+
+	var cursor *TaskWatcherCursor
+	if w.options.Store != nil {
+		saved, err := w.options.Store.LoadCursor(ctx)
+		if err != nil {
+			return err
+		}
+		cursor = saved
+	}
+	if cursor == nil && w.options.Cursor != nil {
+		cursor = w.options.Cursor.clone()
+	}
+	if cursor == nil {
+		head, err := w.head(ctx)
+		if err != nil {
+			return err
+		}
+		cursor = head
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cursor == nil {
+		w.cursor = cursor
+	}
+	return nil
+}
+
+// head returns a cursor after the latest task and the latest finished task
+func (w *TaskWatcher) head(ctx context.Context) (*TaskWatcherCursor, error) {
+	cursor := new(TaskWatcherCursor)
+
+	query := w.query()
+	query.Limit = 1
+	latest, err := w.client.GetTasksWithContext(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+	if len(latest.Results) != 0 {
+		cursor.NextUID = latest.Results[0].UID + 1
+	}
+
+	query.Statuses = []TaskStatus{TaskStatusSucceeded, TaskStatusFailed, TaskStatusCanceled}
+	finished, err := w.client.GetTasksWithContext(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+	if len(finished.Results) != 0 {
+		cursor.FinishedAt = finished.Results[0].FinishedAt
+		cursor.FinishedUIDs = []int64{finished.Results[0].UID}
+	}
+	return cursor, nil
+}
+
+// query returns the filters of the options forwarded to /tasks
+func (w *TaskWatcher) query() TasksQuery {
+	if w.options.Query == nil {
+		return TasksQuery{}
+	}
+	return TasksQuery{
+		UIDS:       w.options.Query.UIDS,
+		Limit:      w.options.Query.Limit,
+		IndexUIDS:  w.options.Query.IndexUIDS,
+		Types:      w.options.Query.Types,
+		CanceledBy: w.options.Query.CanceledBy,
+	}
+}
+
+// events returns the events after cursor in the order they must be committed, cursor is moved
+// after the last one
+func (w *TaskWatcher) events(ctx context.Context, cursor *TaskWatcherCursor) ([]TaskEvent, error) {
+	var events []TaskEvent
+	emit := func(status TaskStatus, task Task) {
+		event := TaskEvent{Status: status, Task: task}
+		cursor.advance(event)
+		events = append(events, event)
+	}
+
+	// the new tasks, in ascending order from the cursor
+	query := w.query()
+	query.From = cursor.NextUID
+	query.Reverse = true
+	for task, err := range w.client.AllTasks(ctx, &query) {
+		if err != nil {
+			return nil, err
+		}
+		emit(TaskStatusEnqueued, task)
+		if task.Status == TaskStatusProcessing {
+			emit(TaskStatusProcessing, task)
+		}
+	}
+
+	// the tasks already seen which started processing since
+	query = w.query()
+	query.Statuses = []TaskStatus{TaskStatusProcessing}
+	for task, err := range w.client.AllTasks(ctx, &query) {
+		if err != nil {
+			return nil, err
+		}
+		if task.UID < cursor.NextUID && !slices.Contains(cursor.Processing, task.UID) {
+			emit(TaskStatusProcessing, task)
+		}
+	}
+
+	// the tasks finished since the cursor, the dates of the query are truncated to the second
+	query = w.query()
+	query.Statuses = []TaskStatus{TaskStatusSucceeded, TaskStatusFailed, TaskStatusCanceled}
+	if !cursor.FinishedAt.IsZero() {
+		query.AfterFinishedAt = cursor.FinishedAt.Add(-time.Second)
+	}
+	var finished []Task
+	for task, err := range w.client.AllTasks(ctx, &query) {
+		if err != nil {
+			return nil, err
+		}
+		if task.FinishedAt.Before(cursor.FinishedAt) ||
+			(task.FinishedAt.Equal(cursor.FinishedAt) && slices.Contains(cursor.FinishedUIDs, task.UID)) {
+			continue
+		}
+		finished = append(finished, task)
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		if !finished[i].FinishedAt.Equal(finished[j].FinishedAt) {
+			return finished[i].FinishedAt.Before(finished[j].FinishedAt)
+		}
+		return finished[i].UID < finished[j].UID
+	})
+	for _, task := range finished {
+		// a task enqueued after the first query is emitted by the next poll, with the tasks finished after it
+		if task.UID >= cursor.NextUID {
+			break
+		}
+		emit(task.Status, task)
+	}
+
+	return events, nil
+}
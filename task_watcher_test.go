@@ -0,0 +1,172 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTasks serves /tasks from an in-memory list of tasks with the filters used by TaskWatcher
+type fakeTasks struct {
+	mu    sync.Mutex
+	tasks []Task
+}
+
+func (f *fakeTasks) set(uid int64, status TaskStatus, finishedAt time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tasks[uid].Status = status
+	f.tasks[uid].FinishedAt = finishedAt
+}
+
+func (f *fakeTasks) add(taskType TaskType) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tasks = append(f.tasks, Task{UID: int64(len(f.tasks)), Type: taskType, Status: TaskStatusEnqueued, IndexUID: "movies"})
+}
+
+func (f *fakeTasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	q := r.URL.Query()
+	reverse := q.Get("reverse") == "true"
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit == 0 {
+		limit = 20
+	}
+	var after time.Time
+	if q.Has("afterFinishedAt") {
+		after, _ = time.Parse(time.RFC3339, q.Get("afterFinishedAt"))
+	}
+
+	var matching []Task
+	for _, task := range f.tasks {
+		if q.Has("statuses") && !slices.Contains(strings.Split(q.Get("statuses"), ","), string(task.Status)) {
+			continue
+		}
+		if q.Has("afterFinishedAt") && !task.FinishedAt.After(after) {
+			continue
+		}
+		if q.Has("from") {
+			from, _ := strconv.ParseInt(q.Get("from"), 10, 64)
+			if (reverse && task.UID < from) || (!reverse && task.UID > from) {
+				continue
+			}
+		}
+		matching = append(matching, task)
+	}
+	if !reverse {
+		slices.Reverse(matching)
+	}
+
+	resp := map[string]interface{}{"results": []Task{}, "next": nil}
+	if len(matching) > limit {
+		resp["next"] = matching[limit].UID
+		matching = matching[:limit]
+	}
+	if len(matching) != 0 {
+		resp["results"] = matching
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func TestTaskWatcher(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 100, time.UTC)
+	t1 := t0.Add(300 * time.Millisecond)
+
+	tasks := &fakeTasks{}
+	tasks.add(TaskTypeIndexCreation)
+	tasks.set(0, TaskStatusSucceeded, t0)
+	tasks.add(TaskTypeDocumentAdditionOrUpdate)
+
+	ts := httptest.NewServer(tasks)
+	defer ts.Close()
+	client := New(ts.URL)
+	store := NewFileCursorStore(filepath.Join(t.TempDir(), "cursor.json"))
+
+	var events []string
+	var purges []int64
+	watch := func() *TaskWatcher {
+		w := NewTaskWatcher(client, &TaskWatcherOptions{Store: store, Query: &TasksQuery{Limit: 1}})
+		w.Handle(func(_ context.Context, e TaskEvent) error {
+			events = append(events, strconv.FormatInt(e.Task.UID, 10)+":"+string(e.Status))
+			return nil
+		})
+		w.Handle(func(_ context.Context, e TaskEvent) error {
+			if e.Status == TaskStatusSucceeded {
+				purges = append(purges, e.Task.UID)
+			}
+			return nil
+		}, TaskTypeDocumentAdditionOrUpdate)
+		return w
+	}
+
+	// the watcher starts after the latest task
+	w := watch()
+	require.NoError(t, w.Poll(context.Background()))
+	require.Empty(t, events)
+	require.Equal(t, &TaskWatcherCursor{NextUID: 2, FinishedAt: t0, FinishedUIDs: []int64{0}}, w.Cursor())
+
+	tasks.set(1, TaskStatusProcessing, time.Time{})
+	tasks.add(TaskTypeSettingsUpdate)
+	tasks.add(TaskTypeDocumentAdditionOrUpdate)
+	require.NoError(t, w.Poll(context.Background()))
+	require.Equal(t, []string{"2:enqueued", "3:enqueued", "1:processing"}, events)
+
+	// tasks finished in the same second as the cursor are not emitted twice
+	events = nil
+	tasks.set(1, TaskStatusSucceeded, t1)
+	tasks.set(2, TaskStatusFailed, t1)
+	require.NoError(t, w.Poll(context.Background()))
+	require.Equal(t, []string{"1:succeeded", "2:failed"}, events)
+	require.Equal(t, []int64{1}, purges)
+
+	// a new watcher resumes from the saved cursor
+	events = nil
+	w = watch()
+	tasks.set(3, TaskStatusSucceeded, t1)
+	require.NoError(t, w.Poll(context.Background()))
+	require.Equal(t, []string{"3:succeeded"}, events)
+	require.Equal(t, []int64{1, 3}, purges)
+
+	// a failed handler stops the poll before the event is committed
+	events = nil
+	tasks.add(TaskTypeIndexDeletion)
+	failing := errors.New("purge failed")
+	w = watch()
+	w.Handle(func(context.Context, TaskEvent) error { return failing })
+	require.ErrorIs(t, w.Poll(context.Background()), failing)
+	require.Equal(t, int64(4), w.Cursor().NextUID)
+
+	events = nil
+	w = watch()
+	require.NoError(t, w.Poll(context.Background()))
+	require.Equal(t, []string{"4:enqueued"}, events)
+
+	// Run reports the failed polls to OnError
+	ctx, cancel := context.WithCancel(context.Background())
+	var pollErrs int
+	w = NewTaskWatcher(New("http://127.0.0.1:0", DisableRetries()), &TaskWatcherOptions{
+		Cursor:   &TaskWatcherCursor{},
+		Interval: time.Millisecond,
+		OnError: func(err error) {
+			if pollErrs++; pollErrs == 2 {
+				cancel()
+			}
+		},
+	})
+	require.ErrorIs(t, w.Run(ctx), context.Canceled)
+	require.Equal(t, 2, pollErrs)
+}
@@ -0,0 +1,96 @@
+package meilisearch
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+// TransportOptions tunes the connections of the client, see WithTransportOptions. The zero values keep
+// the defaults of the client.
+type TransportOptions struct {
+	// DialTimeout is the maximum time to establish a connection, 30s by default
+	DialTimeout time.Duration
+	// KeepAlive is the interval of the TCP keep-alive probes, 30s by default, a negative value disables them
+	KeepAlive time.Duration
+	// TLSHandshakeTimeout is the maximum time of the TLS handshake, 10s by default
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout is the maximum time to wait for the response headers once the request
+	// is written, there is no limit by default
+	ResponseHeaderTimeout time.Duration
+	// IdleConnTimeout closes the connections idle for longer, 90s by default
+	IdleConnTimeout time.Duration
+	// MaxIdleConns is the size of the idle connection pool, 100 by default
+	MaxIdleConns int
+	// MaxIdleConnsPerHost is the size of the idle connection pool of each host, 100 by default
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost limits the connections to each host, there is no limit by default
+	MaxConnsPerHost int
+
+	// TLSConfig is the TLS configuration of the connections
+	TLSConfig *tls.Config
+	// ForceHTTP2 only sends the requests over HTTP/2, the https hosts must negotiate HTTP/2
+	ForceHTTP2 bool
+	// H2C sends the requests to the http hosts over HTTP/2 without TLS (prior knowledge), eg. to a
+	// plaintext internal cluster behind a proxy speaking h2c, the https hosts must negotiate HTTP/2
+	H2C bool
+	// UnixSocket dials the unix domain socket at this path whatever the host, eg. the socket of a
+	// sidecar proxy, the host is still sent in the requests
+	UnixSocket string
+}
+
+// newTransport returns the transport of the options, the zero options give the default transport
+func newTransport(opts TransportOptions) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   durationOrDefault(opts.DialTimeout, 30*time.Second),
+		KeepAlive: durationOrDefault(opts.KeepAlive, 30*time.Second),
+	}
+
+	trans := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          intOrDefault(opts.MaxIdleConns, 100),
+		MaxIdleConnsPerHost:   intOrDefault(opts.MaxIdleConnsPerHost, 100),
+		MaxConnsPerHost:       opts.MaxConnsPerHost,
+		IdleConnTimeout:       durationOrDefault(opts.IdleConnTimeout, 90*time.Second),
+		TLSHandshakeTimeout:   durationOrDefault(opts.TLSHandshakeTimeout, 10*time.Second),
+		ResponseHeaderTimeout: opts.ResponseHeaderTimeout,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       opts.TLSConfig,
+	}
+
+	if opts.UnixSocket != "" {
+		// the proxy would be dialed through the socket
+		trans.Proxy = nil
+		trans.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", opts.UnixSocket)
+		}
+	}
+
+	if opts.ForceHTTP2 || opts.H2C {
+		// HTTP/1.1 is left out, the transport would use it for the http hosts instead of h2c
+		protocols := new(http.Protocols)
+		protocols.SetHTTP2(true)
+		protocols.SetUnencryptedHTTP2(opts.H2C)
+		trans.Protocols = protocols
+		trans.ForceAttemptHTTP2 = true
+	}
+
+	return trans
+}
+
+func durationOrDefault(d, def time.Duration) time.Duration {
+	if d == 0 {
+		return def
+	}
+	return d
+}
+
+func intOrDefault(n, def int) int {
+	if n == 0 {
+		return def
+	}
+	return n
+}
@@ -0,0 +1,95 @@
+package meilisearch
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransport(t *testing.T) {
+	trans := newTransport(TransportOptions{})
+	require.Equal(t, 100, trans.MaxIdleConns)
+	require.Equal(t, 100, trans.MaxIdleConnsPerHost)
+	require.Equal(t, 90*time.Second, trans.IdleConnTimeout)
+	require.Equal(t, 10*time.Second, trans.TLSHandshakeTimeout)
+	require.Zero(t, trans.ResponseHeaderTimeout)
+	require.Nil(t, trans.Protocols)
+	require.NotNil(t, trans.Proxy)
+
+	trans = newTransport(TransportOptions{
+		TLSHandshakeTimeout:   time.Second,
+		ResponseHeaderTimeout: 2 * time.Second,
+		IdleConnTimeout:       3 * time.Second,
+		MaxIdleConns:          10,
+		MaxIdleConnsPerHost:   5,
+		MaxConnsPerHost:       20,
+		ForceHTTP2:            true,
+	})
+	require.Equal(t, time.Second, trans.TLSHandshakeTimeout)
+	require.Equal(t, 2*time.Second, trans.ResponseHeaderTimeout)
+	require.Equal(t, 3*time.Second, trans.IdleConnTimeout)
+	require.Equal(t, 10, trans.MaxIdleConns)
+	require.Equal(t, 5, trans.MaxIdleConnsPerHost)
+	require.Equal(t, 20, trans.MaxConnsPerHost)
+	require.True(t, trans.Protocols.HTTP2())
+	require.False(t, trans.Protocols.HTTP1())
+	require.False(t, trans.Protocols.UnencryptedHTTP2())
+}
+
+func protoServer(protocols *http.Protocols) *httptest.Server {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"pkgVersion":"` + r.Proto + `"}`))
+	}))
+	ts.Config.Protocols = protocols
+	return ts
+}
+
+func TestWithTransportOptions(t *testing.T) {
+	t.Run("h2c", func(t *testing.T) {
+		protocols := new(http.Protocols)
+		protocols.SetHTTP1(true)
+		protocols.SetUnencryptedHTTP2(true)
+		ts := protoServer(protocols)
+		ts.Start()
+		defer ts.Close()
+
+		version, err := New(ts.URL, WithTransportOptions(TransportOptions{H2C: true})).Version()
+		require.NoError(t, err)
+		require.Equal(t, "HTTP/2.0", version.PkgVersion)
+
+		version, err = New(ts.URL).Version()
+		require.NoError(t, err)
+		require.Equal(t, "HTTP/1.1", version.PkgVersion)
+	})
+
+	t.Run("forced HTTP/2", func(t *testing.T) {
+		ts := protoServer(nil)
+		ts.EnableHTTP2 = true
+		ts.StartTLS()
+		defer ts.Close()
+
+		tlsConfig := ts.Client().Transport.(*http.Transport).TLSClientConfig
+		version, err := New(ts.URL, WithTransportOptions(TransportOptions{TLSConfig: tlsConfig, ForceHTTP2: true})).Version()
+		require.NoError(t, err)
+		require.Equal(t, "HTTP/2.0", version.PkgVersion)
+	})
+
+	t.Run("unix socket", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "meilisearch.sock")
+		listener, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		ts := protoServer(nil)
+		ts.Listener = listener
+		ts.Start()
+		defer ts.Close()
+
+		version, err := New("http://meilisearch", WithTransportOptions(TransportOptions{UnixSocket: path})).Version()
+		require.NoError(t, err)
+		require.Equal(t, "HTTP/1.1", version.PkgVersion)
+	})
+}
@@ -0,0 +1,263 @@
+package meilisearch
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// DefaultDocumentsPageSize is the number of documents fetched per request by TypedIndex.Documents
+const DefaultDocumentsPageSize int64 = 1000
+
+// TypedIndex wraps an IndexManager to add, update, get and search documents of a single Go type.
+// The primary key of the index is the field of T tagged `meili:"primary"`, see SchemaFromType.
+//
+//	type Movie struct {
+//		ID    string `json:"id" meili:"primary"`
+//		Title string `json:"title" meili:"searchable"`
+//	}
+//
+//	movies, err := meilisearch.NewTypedIndex[Movie](client.Index("movies"))
+//	task, err := movies.Add([]Movie{{ID: "1", Title: "Carol"}})
+//	movie, err := movies.Get("1")
+type TypedIndex[T any] struct {
+	index  IndexManager
+	schema *IndexSchema
+}
+
+// NewTypedIndex returns a TypedIndex of documents of type T, T must be a struct with a field
+// tagged `meili:"primary"`.
+func NewTypedIndex[T any](index IndexManager) (*TypedIndex[T], error) {
+	schema, err := schemaFromReflectType(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	if schema.PrimaryKey == "" {
+		return nil, fmt.Errorf("%w: %T has no field tagged as primary key", ErrInvalidSchema, *new(T))
+	}
+	return &TypedIndex[T]{index: index, schema: schema}, nil
+}
+
+// Index returns the underlying IndexManager
+func (t *TypedIndex[T]) Index() IndexManager {
+	return t.index
+}
+
+// codec returns the JSON codec of the client of the index, see WithJSONCodec
+func (t *TypedIndex[T]) codec() JSONCodec {
+	if i, ok := t.index.(*index); ok {
+		return i.client.jsonCodec
+	}
+	return EasyJSONCodec
+}
+
+// Schema returns the schema derived from the `meili` tags of T
+func (t *TypedIndex[T]) Schema() *IndexSchema {
+	return t.schema
+}
+
+// ID returns the primary key value of doc, formatted as the string expected by Get and Delete
+func (t *TypedIndex[T]) ID(doc T) (string, error) {
+	v := reflect.ValueOf(&doc).Elem()
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", fmt.Errorf("%w: document is nil", ErrInvalidPrimaryKey)
+		}
+		v = v.Elem()
+	}
+
+	f, err := v.FieldByIndexErr(t.schema.primaryKeyIndex)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q is in a nil embedded struct", ErrInvalidPrimaryKey, t.schema.PrimaryKey)
+	}
+	for f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return "", fmt.Errorf("%w: %q is nil", ErrInvalidPrimaryKey, t.schema.PrimaryKey)
+		}
+		f = f.Elem()
+	}
+
+	var id string
+	switch f.Kind() {
+	case reflect.String:
+		id = f.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		id = strconv.FormatInt(f.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		id = strconv.FormatUint(f.Uint(), 10)
+	default:
+		return "", fmt.Errorf("%w: %q must be a string or an integer, got %s",
+			ErrInvalidPrimaryKey, t.schema.PrimaryKey, f.Type())
+	}
+	if id == "" {
+		return "", fmt.Errorf("%w: %q is empty", ErrInvalidPrimaryKey, t.schema.PrimaryKey)
+	}
+	return id, nil
+}
+
+func (t *TypedIndex[T]) checkIDs(docs []T) error {
+	for i, doc := range docs {
+		if _, err := t.ID(doc); err != nil {
+			return fmt.Errorf("document %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Add adds or replaces documents, the primary key of the index is set from T
+func (t *TypedIndex[T]) Add(docs []T) (*TaskInfo, error) {
+	return t.AddWithContext(context.Background(), docs)
+}
+
+// AddWithContext adds or replaces documents using the provided context for cancellation
+func (t *TypedIndex[T]) AddWithContext(ctx context.Context, docs []T) (*TaskInfo, error) {
+	if err := t.checkIDs(docs); err != nil {
+		return nil, err
+	}
+	return t.index.AddDocumentsWithContext(ctx, docs, t.schema.PrimaryKey)
+}
+
+// AddInBatches adds or replaces documents in batches of batchSize documents
+func (t *TypedIndex[T]) AddInBatches(docs []T, batchSize int) ([]TaskInfo, error) {
+	return t.AddInBatchesWithContext(context.Background(), docs, batchSize)
+}
+
+// AddInBatchesWithContext adds or replaces documents in batches of batchSize documents using the
+// provided context for cancellation
+func (t *TypedIndex[T]) AddInBatchesWithContext(ctx context.Context, docs []T, batchSize int) ([]TaskInfo, error) {
+	if err := t.checkIDs(docs); err != nil {
+		return nil, err
+	}
+	return t.index.AddDocumentsInBatchesWithContext(ctx, docs, batchSize, t.schema.PrimaryKey)
+}
+
+// Update adds or partially updates documents, the primary key of the index is set from T
+func (t *TypedIndex[T]) Update(docs []T) (*TaskInfo, error) {
+	return t.UpdateWithContext(context.Background(), docs)
+}
+
+// UpdateWithContext adds or partially updates documents using the provided context for cancellation
+func (t *TypedIndex[T]) UpdateWithContext(ctx context.Context, docs []T) (*TaskInfo, error) {
+	if err := t.checkIDs(docs); err != nil {
+		return nil, err
+	}
+	return t.index.UpdateDocumentsWithContext(ctx, docs, t.schema.PrimaryKey)
+}
+
+// UpdateInBatches adds or partially updates documents in batches of batchSize documents
+func (t *TypedIndex[T]) UpdateInBatches(docs []T, batchSize int) ([]TaskInfo, error) {
+	return t.UpdateInBatchesWithContext(context.Background(), docs, batchSize)
+}
+
+// UpdateInBatchesWithContext adds or partially updates documents in batches of batchSize documents
+// using the provided context for cancellation
+func (t *TypedIndex[T]) UpdateInBatchesWithContext(ctx context.Context, docs []T, batchSize int) ([]TaskInfo, error) {
+	if err := t.checkIDs(docs); err != nil {
+		return nil, err
+	}
+	return t.index.UpdateDocumentsInBatchesWithContext(ctx, docs, batchSize, t.schema.PrimaryKey)
+}
+
+// Get retrieves the document identified by id
+func (t *TypedIndex[T]) Get(id string) (T, error) {
+	return t.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext retrieves the document identified by id using the provided context for cancellation
+func (t *TypedIndex[T]) GetWithContext(ctx context.Context, id string) (T, error) {
+	var doc T
+	if err := t.index.GetDocumentWithContext(ctx, id, nil, &doc); err != nil {
+		return *new(T), err
+	}
+	return doc, nil
+}
+
+// Delete deletes the documents identified by ids
+func (t *TypedIndex[T]) Delete(ids ...string) (*TaskInfo, error) {
+	return t.DeleteWithContext(context.Background(), ids...)
+}
+
+// DeleteWithContext deletes the documents identified by ids using the provided context for cancellation
+func (t *TypedIndex[T]) DeleteWithContext(ctx context.Context, ids ...string) (*TaskInfo, error) {
+	return t.index.DeleteDocumentsWithContext(ctx, ids)
+}
+
+// Search performs a search query on the index and decodes the hits as T, a nil request searches
+// with the default parameters.
+func (t *TypedIndex[T]) Search(query string, request *SearchRequest) ([]T, error) {
+	return t.SearchWithContext(context.Background(), query, request)
+}
+
+// SearchWithContext performs a search query on the index using the provided context for
+// cancellation and decodes the hits as T
+func (t *TypedIndex[T]) SearchWithContext(ctx context.Context, query string, request *SearchRequest) ([]T, error) {
+	if request == nil {
+		request = &SearchRequest{}
+	}
+	raw, err := t.index.SearchRawWithContext(ctx, query, request)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := struct {
+		Hits []T `json:"hits"`
+	}{}
+	if err := t.codec().Unmarshal(*raw, &resp); err != nil {
+		return nil, fmt.Errorf("unable to decode hits: %w", err)
+	}
+	return resp.Hits, nil
+}
+
+// Documents iterates over all the documents of the index, fetching them by pages of
+// DefaultDocumentsPageSize documents. The iteration stops after the first error.
+//
+//	for movie, err := range movies.Documents(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(movie.Title)
+//	}
+func (t *TypedIndex[T]) Documents(ctx context.Context) iter.Seq2[T, error] {
+	return t.DocumentsWithQuery(ctx, nil)
+}
+
+// DocumentsWithQuery iterates over the documents matching query. query.Limit is used as page size
+// and query.Offset as the position of the first document.
+func (t *TypedIndex[T]) DocumentsWithQuery(ctx context.Context, query *DocumentsQuery) iter.Seq2[T, error] {
+	q := DocumentsQuery{Limit: DefaultDocumentsPageSize}
+	if query != nil {
+		q = *query
+		if q.Limit <= 0 {
+			q.Limit = DefaultDocumentsPageSize
+		}
+	}
+
+	return func(yield func(T, error) bool) {
+		page := q
+		for {
+			resp := new(DocumentsResult)
+			if err := t.index.GetDocumentsWithContext(ctx, &page, resp); err != nil {
+				yield(*new(T), err)
+				return
+			}
+
+			for _, result := range resp.Results {
+				var doc T
+				if err := decodeHit(result, &doc, t.codec()); err != nil {
+					yield(*new(T), err)
+					return
+				}
+				if !yield(doc, nil) {
+					return
+				}
+			}
+
+			page.Offset += int64(len(resp.Results))
+			if int64(len(resp.Results)) < page.Limit || page.Offset >= resp.Total {
+				return
+			}
+		}
+	}
+}
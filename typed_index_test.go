@@ -0,0 +1,138 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type typedTestBase struct {
+	ID int `json:"id" meili:"primary"`
+}
+
+type typedTestMovie struct {
+	*typedTestBase
+	Title string `json:"title" meili:"searchable"`
+}
+
+type typedTestBook struct {
+	ISBN  string `json:"isbn" meili:"primary"`
+	Title string `json:"title"`
+}
+
+func TestNewTypedIndex(t *testing.T) {
+	_, err := NewTypedIndex[struct{ Title string }](New("http://localhost").Index("movies"))
+	require.ErrorIs(t, err, ErrInvalidSchema)
+
+	_, err = NewTypedIndex[[]string](New("http://localhost").Index("movies"))
+	require.ErrorIs(t, err, ErrInvalidSchema)
+
+	movies, err := NewTypedIndex[typedTestMovie](New("http://localhost").Index("movies"))
+	require.NoError(t, err)
+	require.Equal(t, "id", movies.Schema().PrimaryKey)
+
+	id, err := movies.ID(typedTestMovie{typedTestBase: &typedTestBase{ID: 42}})
+	require.NoError(t, err)
+	require.Equal(t, "42", id)
+
+	_, err = movies.ID(typedTestMovie{Title: "no base"})
+	require.ErrorIs(t, err, ErrInvalidPrimaryKey)
+
+	books, err := NewTypedIndex[*typedTestBook](New("http://localhost").Index("books"))
+	require.NoError(t, err)
+
+	_, err = books.ID(nil)
+	require.ErrorIs(t, err, ErrInvalidPrimaryKey)
+
+	_, err = books.Add([]*typedTestBook{{ISBN: "1"}, {Title: "no isbn"}})
+	require.ErrorIs(t, err, ErrInvalidPrimaryKey)
+}
+
+func TestTypedIndex(t *testing.T) {
+	docs := []typedTestBook{
+		{ISBN: "1", Title: "Carol"},
+		{ISBN: "2", Title: "Wonder Woman"},
+		{ISBN: "3", Title: "Life of Pi"},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/indexes/books/documents":
+			require.Equal(t, "isbn", r.URL.Query().Get("primaryKey"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `[{"isbn": "1", "title": "Carol"}]`, string(body))
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid": 1, "indexUid": "books", "status": "enqueued", "type": "documentAdditionOrUpdate"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/indexes/books/documents/2":
+			_, _ = w.Write([]byte(`{"isbn": "2", "title": "Wonder Woman"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/indexes/books/documents":
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			end := offset + limit
+			if end > len(docs) {
+				end = len(docs)
+			}
+			b, err := json.Marshal(map[string]interface{}{
+				"results": docs[offset:end], "limit": limit, "offset": offset, "total": len(docs),
+			})
+			require.NoError(t, err)
+			_, _ = w.Write(b)
+		case r.Method == http.MethodPost && r.URL.Path == "/indexes/books/search":
+			_, _ = w.Write([]byte(`{"hits": [{"isbn": "3", "title": "Life of Pi", "_rankingScore": 0.9}], "query": "pi"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/indexes/books/documents/delete-batch":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, `["1", "2"]`, string(body))
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid": 2, "indexUid": "books", "status": "enqueued", "type": "documentDeletion"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "not found", "code": "document_not_found", "type": "invalid_request", "link": ""}`))
+		}
+	}))
+	defer ts.Close()
+
+	books, err := NewTypedIndex[typedTestBook](New(ts.URL).Index("books"))
+	require.NoError(t, err)
+
+	task, err := books.Add(docs[:1])
+	require.NoError(t, err)
+	require.Equal(t, int64(1), task.TaskUID)
+
+	book, err := books.Get("2")
+	require.NoError(t, err)
+	require.Equal(t, docs[1], book)
+
+	_, err = books.Get("404")
+	require.Error(t, err)
+
+	hits, err := books.Search("pi", nil)
+	require.NoError(t, err)
+	require.Equal(t, []typedTestBook{docs[2]}, hits)
+
+	task, err = books.Delete("1", "2")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), task.TaskUID)
+
+	var got []typedTestBook
+	for doc, err := range books.DocumentsWithQuery(context.Background(), &DocumentsQuery{Limit: 2}) {
+		require.NoError(t, err)
+		got = append(got, doc)
+	}
+	require.Equal(t, docs, got)
+
+	// stopping the iteration early does not fetch the next pages
+	got = got[:0]
+	for doc := range books.Documents(context.Background()) {
+		got = append(got, doc)
+		break
+	}
+	require.Equal(t, docs[:1], got)
+}
@@ -21,6 +21,7 @@ const (
 	GzipEncoding    ContentEncoding = "gzip"
 	DeflateEncoding ContentEncoding = "deflate"
 	BrotliEncoding  ContentEncoding = "br"
+	ZstdEncoding    ContentEncoding = "zstd"
 
 	NoCompression          EncodingCompressionLevel = 0
 	BestSpeed              EncodingCompressionLevel = 1
@@ -227,6 +228,8 @@ const (
 	TaskTypeDocumentAdditionOrUpdate TaskType = "documentAdditionOrUpdate"
 	// TaskTypeDocumentDeletion represents a document deletion from an index
 	TaskTypeDocumentDeletion TaskType = "documentDeletion"
+	// TaskTypeDocumentEdition represents a document edition by a function in an index
+	TaskTypeDocumentEdition TaskType = "documentEdition"
 	// TaskTypeSettingsUpdate represents a settings update
 	TaskTypeSettingsUpdate TaskType = "settingsUpdate"
 	// TaskTypeDumpCreation represents a dump creation
@@ -239,24 +242,6 @@ const (
 	TaskTypeSnapshotCreation TaskType = "snapshotCreation"
 )
 
-// Task indicates information about a task resource
-//
-// Documentation: https://www.meilisearch.com/docs/learn/advanced/asynchronous_operations
-type Task struct {
-	Status     TaskStatus          `json:"status"`
-	UID        int64               `json:"uid,omitempty"`
-	TaskUID    int64               `json:"taskUid,omitempty"`
-	IndexUID   string              `json:"indexUid"`
-	Type       TaskType            `json:"type"`
-	Error      meilisearchApiError `json:"error,omitempty"`
-	Duration   string              `json:"duration,omitempty"`
-	EnqueuedAt time.Time           `json:"enqueuedAt"`
-	StartedAt  time.Time           `json:"startedAt,omitempty"`
-	FinishedAt time.Time           `json:"finishedAt,omitempty"`
-	Details    Details             `json:"details,omitempty"`
-	CanceledBy int64               `json:"canceledBy,omitempty"`
-}
-
 // TaskInfo indicates information regarding a task returned by an asynchronous method
 //
 // Documentation: https://www.meilisearch.com/docs/reference/api/tasks#tasks
@@ -313,31 +298,6 @@ type DeleteTasksQuery struct {
 	AfterFinishedAt  time.Time
 }
 
-type Details struct {
-	ReceivedDocuments    int64               `json:"receivedDocuments,omitempty"`
-	IndexedDocuments     int64               `json:"indexedDocuments,omitempty"`
-	DeletedDocuments     int64               `json:"deletedDocuments,omitempty"`
-	PrimaryKey           string              `json:"primaryKey,omitempty"`
-	ProvidedIds          int64               `json:"providedIds,omitempty"`
-	RankingRules         []string            `json:"rankingRules,omitempty"`
-	DistinctAttribute    *string             `json:"distinctAttribute,omitempty"`
-	SearchableAttributes []string            `json:"searchableAttributes,omitempty"`
-	DisplayedAttributes  []string            `json:"displayedAttributes,omitempty"`
-	StopWords            []string            `json:"stopWords,omitempty"`
-	Synonyms             map[string][]string `json:"synonyms,omitempty"`
-	FilterableAttributes []string            `json:"filterableAttributes,omitempty"`
-	SortableAttributes   []string            `json:"sortableAttributes,omitempty"`
-	TypoTolerance        *TypoTolerance      `json:"typoTolerance,omitempty"`
-	Pagination           *Pagination         `json:"pagination,omitempty"`
-	Faceting             *Faceting           `json:"faceting,omitempty"`
-	MatchedTasks         int64               `json:"matchedTasks,omitempty"`
-	CanceledTasks        int64               `json:"canceledTasks,omitempty"`
-	DeletedTasks         int64               `json:"deletedTasks,omitempty"`
-	OriginalFilter       string              `json:"originalFilter,omitempty"`
-	Swaps                []SwapIndexesParams `json:"swaps,omitempty"`
-	DumpUid              string              `json:"dumpUid,omitempty"`
-}
-
 // TaskResult return of multiple tasks is wrap in a TaskResult
 type TaskResult struct {
 	Results []Task `json:"results"`
@@ -474,19 +434,32 @@ type MultiSearchFederation struct {
 
 // SearchResponse is the response body for search method
 type SearchResponse struct {
-	Hits               []interface{} `json:"hits"`
-	EstimatedTotalHits int64         `json:"estimatedTotalHits,omitempty"`
-	Offset             int64         `json:"offset,omitempty"`
-	Limit              int64         `json:"limit,omitempty"`
-	ProcessingTimeMs   int64         `json:"processingTimeMs"`
-	Query              string        `json:"query"`
-	FacetDistribution  interface{}   `json:"facetDistribution,omitempty"`
-	TotalHits          int64         `json:"totalHits,omitempty"`
-	HitsPerPage        int64         `json:"hitsPerPage,omitempty"`
-	Page               int64         `json:"page,omitempty"`
-	TotalPages         int64         `json:"totalPages,omitempty"`
-	FacetStats         interface{}   `json:"facetStats,omitempty"`
-	IndexUID           string        `json:"indexUid,omitempty"`
+	Hits               []interface{}     `json:"hits"`
+	EstimatedTotalHits int64             `json:"estimatedTotalHits,omitempty"`
+	Offset             int64             `json:"offset,omitempty"`
+	Limit              int64             `json:"limit,omitempty"`
+	ProcessingTimeMs   int64             `json:"processingTimeMs"`
+	Query              string            `json:"query"`
+	FacetDistribution  FacetDistribution `json:"facetDistribution,omitempty"`
+	TotalHits          int64             `json:"totalHits,omitempty"`
+	HitsPerPage        int64             `json:"hitsPerPage,omitempty"`
+	Page               int64             `json:"page,omitempty"`
+	TotalPages         int64             `json:"totalPages,omitempty"`
+	FacetStats         FacetStats        `json:"facetStats,omitempty"`
+	IndexUID           string            `json:"indexUid,omitempty"`
+}
+
+// FacetDistribution is the number of matching documents for each value of each requested facet,
+// indexed by facet name then by facet value.
+type FacetDistribution map[string]map[string]int64
+
+// FacetStats is the lowest and highest value of each numeric facet, indexed by facet name.
+type FacetStats map[string]FacetStat
+
+// FacetStat is the lowest and highest value found for a numeric facet
+type FacetStat struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
 }
 
 type MultiSearchResponse struct {
@@ -509,9 +482,15 @@ type FacetSearchRequest struct {
 }
 
 type FacetSearchResponse struct {
-	FacetHits        []interface{} `json:"facetHits"`
-	FacetQuery       string        `json:"facetQuery"`
-	ProcessingTimeMs int64         `json:"processingTimeMs"`
+	FacetHits        []FacetHit `json:"facetHits"`
+	FacetQuery       string     `json:"facetQuery"`
+	ProcessingTimeMs int64      `json:"processingTimeMs"`
+}
+
+// FacetHit is a facet value with the number of matching documents holding it
+type FacetHit struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
 }
 
 // DocumentQuery is the request body get one documents method
@@ -568,23 +547,6 @@ type UpdateDocumentByFunctionRequest struct {
 	Context  map[string]interface{} `json:"context,omitempty"`
 }
 
-// ExperimentalFeaturesResult represents the experimental features result from the API.
-type ExperimentalFeaturesBase struct {
-	VectorStore             *bool `json:"vectorStore,omitempty"`
-	LogsRoute               *bool `json:"logsRoute,omitempty"`
-	Metrics                 *bool `json:"metrics,omitempty"`
-	EditDocumentsByFunction *bool `json:"editDocumentsByFunction,omitempty"`
-	ContainsFilter          *bool `json:"containsFilter,omitempty"`
-}
-
-type ExperimentalFeaturesResult struct {
-	VectorStore             bool `json:"vectorStore"`
-	LogsRoute               bool `json:"logsRoute"`
-	Metrics                 bool `json:"metrics"`
-	EditDocumentsByFunction bool `json:"editDocumentsByFunction"`
-	ContainsFilter          bool `json:"containsFilter"`
-}
-
 type SwapIndexesParams struct {
 	Indexes []string `json:"indexes"`
 }